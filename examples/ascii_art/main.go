@@ -49,8 +49,7 @@ func (m model) View() string {
 	art := bubbleviews.ASCIIArtNode{
 		Lines: lines,
 		Align: bubbleviews.AlignCenter,
-		Bold:  true,
-		Color: bubbleviews.Color("63"),
+		Style: bubbleviews.TextStyle{Color: bubbleviews.Color("63"), Bold: true},
 	}
 	view := bubbleviews.View{
 		Size: bubbleviews.Size{Width: m.width, Height: m.height},
@@ -8,9 +8,17 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/input"
 	"github.com/sprucelabsai-community/bubbleviews/render"
 )
 
+const (
+	addCameraID     = "add-camera"
+	cameraGridID    = "camera-grid"
+	cameraScrollID  = "camera-scroll"
+	confirmDialogID = "confirm-dialog"
+)
+
 type cameraStatus struct {
 	id          string
 	name        string
@@ -20,10 +28,12 @@ type cameraStatus struct {
 }
 
 type statusState struct {
-	booting   bool
-	cameras   []cameraStatus
-	selected  selection
-	lastEvent string
+	booting    bool
+	cameras    []cameraStatus
+	selected   selection
+	lastEvent  string // markup, see bubbleviews.ParseMarkup
+	confirming bool
+	gridOffset int // first visible row of the camera grid
 }
 
 type selection struct {
@@ -35,10 +45,12 @@ type model struct {
 	width  int
 	height int
 	state  statusState
+	layout render.LayoutTree
+	mouse  input.Router
 }
 
 func newModel() *model {
-	return &model{
+	m := &model{
 		state: statusState{
 			booting:   true,
 			cameras:   []cameraStatus{},
@@ -46,6 +58,21 @@ func newModel() *model {
 			lastEvent: "Booting recorder service…",
 		},
 	}
+
+	m.mouse.OnClick(addCameraID, func(input.Event) tea.Cmd {
+		return func() tea.Msg { return addCameraMsg{} }
+	})
+	m.mouse.OnWheel(cameraGridID, func(e input.Event) tea.Cmd {
+		switch e.Mouse.Button {
+		case tea.MouseButtonWheelDown:
+			m.advanceFocus(1)
+		case tea.MouseButtonWheelUp:
+			m.advanceFocus(-1)
+		}
+		return nil
+	})
+
+	return m
 }
 
 func (m *model) Init() tea.Cmd {
@@ -65,7 +92,33 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+	case tea.MouseMsg:
+		if m.state.confirming {
+			// Clicking anywhere outside the dialog dismisses it.
+			if msg.Action == tea.MouseActionPress && !m.overDialog(msg) {
+				m.state.confirming = false
+			}
+			return m, nil
+		}
+		cmd, _ := m.mouse.Route(m.layout, msg)
+		if msg.Action == tea.MouseActionPress {
+			m.revealFocusedCamera()
+		}
+		return m, cmd
 	case tea.KeyMsg:
+		if m.state.confirming {
+			switch msg.String() {
+			case "y", "enter":
+				m.state.confirming = false
+				return m, func() tea.Msg { return removeCameraMsg{} }
+			case "n", "esc":
+				m.state.confirming = false
+			case "ctrl+c":
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
@@ -78,7 +131,7 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, func() tea.Msg { return addCameraMsg{} }
 			}
 			if idx := m.state.selected.cameraIdx; idx >= 0 && idx < len(m.state.cameras) {
-				return m, func() tea.Msg { return removeCameraMsg{} }
+				m.state.confirming = true
 			}
 		}
 	case tickMsg:
@@ -90,9 +143,37 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.removeFocusedCamera()
 	}
 
+	m.revealFocusedCamera()
 	return m, nil
 }
 
+func (m *model) overDialog(msg tea.MouseMsg) bool {
+	hit, ok := input.HitTest(m.layout, msg)
+	if !ok {
+		return false
+	}
+	if hit.ID == confirmDialogID {
+		return true
+	}
+	for _, id := range hit.Ancestors {
+		if id == confirmDialogID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *model) focusCamera(id string) bool {
+	for idx, cam := range m.state.cameras {
+		if cam.id == id {
+			m.state.selected.inSummary = false
+			m.state.selected.cameraIdx = idx
+			return true
+		}
+	}
+	return false
+}
+
 func (m *model) advanceFocus(direction int) {
 	if m.state.selected.inSummary {
 		if len(m.state.cameras) == 0 {
@@ -141,7 +222,16 @@ func (m *model) addCamera() {
 	m.state.selected.inSummary = false
 	m.state.selected.cameraIdx = len(m.state.cameras) - 1
 	m.state.booting = false
-	m.state.lastEvent = fmt.Sprintf("Started %s", cam.name)
+	m.state.lastEvent = fmt.Sprintf("Started **%s**", bubbleviews.EscapeMarkup(cam.name))
+
+	m.mouse.OnClick(cam.id, func(input.Event) tea.Cmd {
+		m.focusCamera(cam.id)
+		return nil
+	})
+	m.mouse.OnClick(removeButtonID(cam.id), func(input.Event) tea.Cmd {
+		m.state.confirming = m.focusCamera(cam.id)
+		return nil
+	})
 }
 
 func (m *model) removeFocusedCamera() {
@@ -151,7 +241,9 @@ func (m *model) removeFocusedCamera() {
 	}
 	removed := m.state.cameras[idx]
 	m.state.cameras = append(m.state.cameras[:idx], m.state.cameras[idx+1:]...)
-	m.state.lastEvent = fmt.Sprintf("Stopped %s", removed.name)
+	m.mouse.Off(removed.id)
+	m.mouse.Off(removeButtonID(removed.id))
+	m.state.lastEvent = fmt.Sprintf("Stopped [%s](color:203)", bubbleviews.EscapeMarkup(removed.name))
 	if len(m.state.cameras) == 0 {
 		m.state.selected.inSummary = true
 		m.state.selected.cameraIdx = -1
@@ -179,6 +271,42 @@ func (m *model) updateMetrics() {
 }
 
 func (m *model) View() string {
+	m.layout = render.Layout(m.buildView())
+	return render.Paint(m.layout)
+}
+
+// revealFocusedCamera scrolls the camera grid so the focused panel is visible,
+// favouring its Remove button when the panel is taller than the viewport. It
+// measures a fresh layout of the current state, since focus, size or the
+// camera list may have changed since the last frame.
+func (m *model) revealFocusedCamera() {
+	laid := render.Layout(m.buildView())
+	scroll, ok := laid.Find(cameraScrollID)
+	if !ok {
+		return
+	}
+	content, viewport, ok := scroll.ScrollExtent()
+	if !ok {
+		return
+	}
+
+	offset := m.state.gridOffset
+	if idx := m.state.selected.cameraIdx; !m.state.selected.inSummary && idx >= 0 && idx < len(m.state.cameras) {
+		if panel, ok := laid.Find(m.state.cameras[idx].id); ok {
+			top := panel.Rect.Y - scroll.Content.Y + offset
+			bottom := top + panel.Rect.Height
+			if top < offset {
+				offset = top
+			}
+			if bottom > offset+viewport.Height {
+				offset = bottom - viewport.Height
+			}
+		}
+	}
+	m.state.gridOffset = bubbleviews.ClampScroll(offset, content.Height, viewport.Height)
+}
+
+func (m *model) buildView() bubbleviews.View {
 	var items []bubbleviews.FlexItem
 
 	if len(m.state.cameras) > 0 {
@@ -187,7 +315,7 @@ func (m *model) View() string {
 	}
 
 	grid := buildCameraGrid(m.state)
-	items = append(items, bubbleviews.FlexItem{Node: grid})
+	items = append(items, bubbleviews.FlexItem{Node: grid, Grow: 1})
 
 	layout := bubbleviews.FlexNode{
 		Direction: bubbleviews.FlexDirectionColumn,
@@ -195,12 +323,61 @@ func (m *model) View() string {
 		Items:     items,
 	}
 
-	view := bubbleviews.View{
+	var root bubbleviews.Node = layout
+	if m.state.confirming {
+		root = bubbleviews.LayerNode{
+			Layers: []bubbleviews.Layer{
+				{Node: layout},
+				{
+					Node:   buildConfirmDialog(m.state.cameras[m.state.selected.cameraIdx]),
+					HAlign: bubbleviews.AlignCenter,
+					VAlign: bubbleviews.AlignCenter,
+				},
+			},
+		}
+	}
+
+	return bubbleviews.View{
 		Size:     bubbleviews.Size{Width: m.width, Height: m.height},
-		Children: []bubbleviews.Node{layout},
+		Overflow: bubbleviews.OverflowClip,
+		Children: []bubbleviews.Node{root},
 	}
+}
 
-	return render.Render(view)
+func buildConfirmDialog(cam cameraStatus) bubbleviews.Node {
+	return bubbleviews.BoxNode{
+		ID: confirmDialogID,
+		Style: bubbleviews.BoxStyle{
+			Border:      bubbleviews.BorderThick,
+			BorderColor: bubbleviews.Color("205"),
+			Background:  bubbleviews.Color("236"),
+			Padding:     bubbleviews.Padding{Top: 1, Bottom: 1, Left: 3, Right: 3},
+		},
+		Content: bubbleviews.View{
+			Children: []bubbleviews.Node{
+				bubbleviews.TextNode{
+					Value: fmt.Sprintf("Stop recording %s?", cam.name),
+					Style: bubbleviews.TextStyle{Bold: true},
+				},
+				bubbleviews.TextNode{
+					Value: "y / enter to confirm · n / esc to cancel",
+					Style: bubbleviews.TextStyle{Color: bubbleviews.Color("244")},
+				},
+			},
+		},
+	}
+}
+
+// buildEventMessage renders an event message written in markup, falling back
+// to the raw text should the markup be malformed.
+func buildEventMessage(message string) bubbleviews.Node {
+	style := bubbleviews.TextStyle{Color: bubbleviews.Color("244")}
+	node, err := bubbleviews.ParseMarkup(message)
+	if err != nil {
+		return bubbleviews.TextNode{Value: message, Style: style}
+	}
+	node.Style = style
+	return node
 }
 
 func buildSummaryRow(state statusState) bubbleviews.Node {
@@ -216,33 +393,25 @@ func buildSummaryRow(state statusState) bubbleviews.Node {
 	}
 
 	flexItems := []bubbleviews.FlexItem{
-		{
-			Node: bubbleviews.TextNode{
-				Value: "Streaming Recorder Service",
-				Bold:  true,
-			},
-		},
 		{
 			Node: bubbleviews.TextNode{
 				Value: statusLine,
-				Color: bubbleviews.Color("36"),
+				Style: bubbleviews.TextStyle{Color: bubbleviews.Color("36")},
 			},
 		},
 		{
 			Node: bubbleviews.TextNode{
 				Value: cameraCount,
-				Color: bubbleviews.Color("63"),
+				Style: bubbleviews.TextStyle{Color: bubbleviews.Color("63")},
 			},
 		},
 		{
-			Node: bubbleviews.TextNode{
-				Value: message,
-				Color: bubbleviews.Color("244"),
-			},
+			Node: buildEventMessage(message),
 		},
 	}
 
 	addButton := bubbleviews.BoxNode{
+		ID: addCameraID,
 		Style: bubbleviews.BoxStyle{
 			Border:      bubbleviews.BorderThin,
 			BorderColor: buttonBorderColor(state.selected.inSummary),
@@ -252,7 +421,7 @@ func buildSummaryRow(state statusState) bubbleviews.Node {
 			Children: []bubbleviews.Node{
 				bubbleviews.TextNode{
 					Value: "+ Add Camera",
-					Bold:  state.selected.inSummary,
+					Style: bubbleviews.TextStyle{Bold: state.selected.inSummary},
 				},
 			},
 		},
@@ -266,6 +435,7 @@ func buildSummaryRow(state statusState) bubbleviews.Node {
 			BorderColor: bubbleviews.Color("63"),
 			Padding:     bubbleviews.Padding{Top: 1, Bottom: 1, Left: 2, Right: 2},
 			FillWidth:   true,
+			Title:       "Streaming Recorder Service",
 		},
 		Content: bubbleviews.View{
 			Children: []bubbleviews.Node{
@@ -295,13 +465,14 @@ func buildCameraGrid(state statusState) bubbleviews.Node {
 				Children: []bubbleviews.Node{
 					bubbleviews.TextNode{
 						Value: "No active cameras detected.",
-						Color: bubbleviews.Color("244"),
+						Style: bubbleviews.TextStyle{Color: bubbleviews.Color("244")},
 					},
 					bubbleviews.TextNode{
 						Value: "Press TAB or Enter to add a camera.",
-						Color: bubbleviews.Color("62"),
+						Style: bubbleviews.TextStyle{Color: bubbleviews.Color("62")},
 					},
 					bubbleviews.BoxNode{
+						ID: addCameraID,
 						Style: bubbleviews.BoxStyle{
 							Border:      bubbleviews.BorderThin,
 							BorderColor: buttonBorderColor(true),
@@ -311,7 +482,7 @@ func buildCameraGrid(state statusState) bubbleviews.Node {
 							Children: []bubbleviews.Node{
 								bubbleviews.TextNode{
 									Value: "+ Add Camera",
-									Bold:  true,
+									Style: bubbleviews.TextStyle{Bold: true},
 								},
 							},
 						},
@@ -345,36 +516,49 @@ func buildCameraGrid(state statusState) bubbleviews.Node {
 	}
 
 	return bubbleviews.BoxNode{
+		ID: cameraGridID,
 		Style: bubbleviews.BoxStyle{
 			Border:      bubbleviews.BorderThin,
 			BorderColor: bubbleviews.Color("63"),
 			Padding:     bubbleviews.Padding{Top: 1, Bottom: 1, Left: 2, Right: 2},
 			FillWidth:   true,
 			FillHeight:  true,
+			Title:       "Cameras",
+			Footer:      "tab focus · enter remove · q quit",
+			FooterAlign: bubbleviews.AlignEnd,
 		},
 		Content: bubbleviews.View{
-			Children: []bubbleviews.Node{gridContents},
+			Children: []bubbleviews.Node{bubbleviews.ScrollNode{
+				ID:             cameraScrollID,
+				Node:           gridContents,
+				OffsetY:        state.gridOffset,
+				Scrollbar:      true,
+				ScrollbarColor: bubbleviews.Color("238"),
+				ThumbColor:     bubbleviews.Color("63"),
+			}},
 		},
 	}
 }
 
 func buildCameraPanel(cam cameraStatus, focused bool) bubbleviews.Node {
-	header := bubbleviews.TextNode{
-		Value: cam.name,
-		Bold:  true,
-	}
-
 	metrics := bubbleviews.FlexNode{
 		Direction: bubbleviews.FlexDirectionColumn,
 		Spacing:   0,
 		Items: []bubbleviews.FlexItem{
-			{Node: bubbleviews.TextNode{Value: fmt.Sprintf("FPS: %d", cam.fps)}},
-			{Node: bubbleviews.TextNode{Value: fmt.Sprintf("Dropped frames: %d", cam.dropped)}},
-			{Node: bubbleviews.TextNode{Value: cam.lastMessage, Color: bubbleviews.Color("244")}},
+			{Node: bubbleviews.RichTextNode{Spans: []bubbleviews.Span{
+				{Text: "FPS: "},
+				{Text: fmt.Sprint(cam.fps), Style: bubbleviews.TextStyle{Color: bubbleviews.Color("42"), Bold: true}},
+			}}},
+			{Node: bubbleviews.RichTextNode{Spans: []bubbleviews.Span{
+				{Text: "Dropped frames: "},
+				{Text: fmt.Sprint(cam.dropped), Style: bubbleviews.TextStyle{Color: droppedColor(cam.dropped), Bold: true}},
+			}}},
+			{Node: bubbleviews.TextNode{Value: cam.lastMessage, Style: bubbleviews.TextStyle{Color: bubbleviews.Color("244")}}},
 		},
 	}
 
 	removeButton := bubbleviews.BoxNode{
+		ID: removeButtonID(cam.id),
 		Style: bubbleviews.BoxStyle{
 			Border:      bubbleviews.BorderThin,
 			BorderColor: buttonBorderColor(focused),
@@ -384,21 +568,22 @@ func buildCameraPanel(cam cameraStatus, focused bool) bubbleviews.Node {
 			Children: []bubbleviews.Node{
 				bubbleviews.TextNode{
 					Value: "Remove",
-					Bold:  focused,
+					Style: bubbleviews.TextStyle{Bold: focused},
 				},
 			},
 		},
 	}
 
 	return bubbleviews.BoxNode{
+		ID: cam.id,
 		Style: bubbleviews.BoxStyle{
 			Border:      bubbleviews.BorderThin,
 			BorderColor: bubbleviews.Color("63"),
 			Padding:     bubbleviews.Padding{Top: 1, Bottom: 1, Left: 2, Right: 2},
+			Title:       cam.name,
 		},
 		Content: bubbleviews.View{
 			Children: []bubbleviews.Node{
-				header,
 				metrics,
 				removeButton,
 			},
@@ -406,6 +591,17 @@ func buildCameraPanel(cam cameraStatus, focused bool) bubbleviews.Node {
 	}
 }
 
+func removeButtonID(cameraID string) string {
+	return cameraID + "/remove"
+}
+
+func droppedColor(dropped int) bubbleviews.Color {
+	if dropped > 0 {
+		return bubbleviews.Color("203")
+	}
+	return bubbleviews.Color("42")
+}
+
 func buttonBorderColor(focused bool) bubbleviews.Color {
 	if focused {
 		return bubbleviews.Color("205")
@@ -415,7 +611,7 @@ func buttonBorderColor(focused bool) bubbleviews.Color {
 
 func main() {
 	rand.Seed(time.Now().UnixNano())
-	p := tea.NewProgram(newModel(), tea.WithAltScreen())
+	p := tea.NewProgram(newModel(), tea.WithAltScreen(), tea.WithMouseCellMotion())
 	if _, err := p.Run(); err != nil {
 		log.Fatal(err)
 	}
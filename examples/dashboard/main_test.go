@@ -1,6 +1,11 @@
 package main
 
-import "testing"
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews/input"
+)
 
 func TestSnapshot(t *testing.T) {
 	m := newModel()
@@ -10,3 +15,75 @@ func TestSnapshot(t *testing.T) {
 	out := m.View()
 	t.Logf("\n%s", out)
 }
+
+func TestConfirmDialogSnapshot(t *testing.T) {
+	m := newModel()
+	m.width = 80
+	m.height = 24
+	m.addCamera()
+	m.state.confirming = true
+	out := m.View()
+	t.Logf("\n%s", out)
+}
+
+func TestMouseRemoveOpensConfirmDialog(t *testing.T) {
+	m := newModel()
+	m.addCamera()
+	m.addCamera()
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	m.View()
+
+	target := removeButtonID(m.state.cameras[0].id)
+	button, ok := m.layout.Find(target)
+	if !ok {
+		t.Fatalf("no layout for %s", target)
+	}
+
+	m.Update(tea.MouseMsg{X: button.Rect.X + 1, Y: button.Rect.Y + 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	if !m.state.confirming || m.state.selected.cameraIdx != 0 {
+		t.Fatalf("expected confirm dialog for first camera, got confirming=%v idx=%d", m.state.confirming, m.state.selected.cameraIdx)
+	}
+	t.Logf("\n%s", m.View())
+}
+
+func TestFocusScrollsCameraIntoView(t *testing.T) {
+	m := newModel()
+	for range 4 {
+		m.addCamera()
+	}
+
+	// Sizing the window reveals the last camera added; the keys then move
+	// focus back to the first camera and on to the third.
+	tab, shiftTab := tea.KeyMsg{Type: tea.KeyTab}, tea.KeyMsg{Type: tea.KeyShiftTab}
+	steps := []struct {
+		msgs []tea.Msg
+		idx  int
+	}{
+		{msgs: []tea.Msg{tea.WindowSizeMsg{Width: 80, Height: 24}}, idx: 3},
+		{msgs: []tea.Msg{shiftTab, shiftTab, shiftTab}, idx: 0},
+		{msgs: []tea.Msg{tab, tab}, idx: 2},
+	}
+	for _, step := range steps {
+		for _, msg := range step.msgs {
+			m.Update(msg)
+		}
+		idx := step.idx
+		if m.state.selected.cameraIdx != idx {
+			t.Fatalf("expected camera %d focused, got %d", idx, m.state.selected.cameraIdx)
+		}
+		m.View()
+
+		target := removeButtonID(m.state.cameras[idx].id)
+		button, ok := m.layout.Find(target)
+		if !ok {
+			t.Fatalf("no layout for %s", target)
+		}
+		hit, ok := input.HitTest(m.layout, tea.MouseMsg{X: button.Rect.X + 1, Y: button.Rect.Y + 1})
+		if !ok || hit.ID != target {
+			t.Fatalf("camera %d: expected its Remove button to be visible at offset %d, hit %+v", idx, m.state.gridOffset, hit)
+		}
+	}
+	if m.state.gridOffset == 0 {
+		t.Fatal("expected the grid to stay scrolled for the second row")
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/sprucelabsai-community/bubbleviews/render"
 )
 
+const cameraScrollID = "camera-scroll"
+
 type cameraStatus struct {
 	id          string
 	name        string
@@ -20,10 +22,11 @@ type cameraStatus struct {
 }
 
 type statusState struct {
-	booting   bool
-	cameras   []cameraStatus
-	selected  selection
-	lastEvent string
+	booting    bool
+	cameras    []cameraStatus
+	selected   selection
+	lastEvent  string
+	gridOffset int // first visible row of the camera grid
 }
 
 type selection struct {
@@ -35,6 +38,7 @@ type model struct {
 	width  int
 	height int
 	state  statusState
+	layout render.LayoutTree
 }
 
 func newModel() *model {
@@ -90,6 +94,7 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.removeFocusedCamera()
 	}
 
+	m.revealFocusedCamera()
 	return m, nil
 }
 
@@ -179,6 +184,42 @@ func (m *model) updateMetrics() {
 }
 
 func (m *model) View() string {
+	m.layout = render.Layout(m.buildView())
+	return render.Paint(m.layout)
+}
+
+// revealFocusedCamera scrolls the camera grid so the focused panel is visible,
+// favouring its Remove button when the panel is taller than the viewport. It
+// measures a fresh layout of the current state, since focus, size or the
+// camera list may have changed since the last frame.
+func (m *model) revealFocusedCamera() {
+	laid := render.Layout(m.buildView())
+	scroll, ok := laid.Find(cameraScrollID)
+	if !ok {
+		return
+	}
+	content, viewport, ok := scroll.ScrollExtent()
+	if !ok {
+		return
+	}
+
+	offset := m.state.gridOffset
+	if idx := m.state.selected.cameraIdx; !m.state.selected.inSummary && idx >= 0 && idx < len(m.state.cameras) {
+		if panel, ok := laid.Find(m.state.cameras[idx].id); ok {
+			top := panel.Rect.Y - scroll.Content.Y + offset
+			bottom := top + panel.Rect.Height
+			if top < offset {
+				offset = top
+			}
+			if bottom > offset+viewport.Height {
+				offset = bottom - viewport.Height
+			}
+		}
+	}
+	m.state.gridOffset = bubbleviews.ClampScroll(offset, content.Height, viewport.Height)
+}
+
+func (m *model) buildView() bubbleviews.View {
 	var items []bubbleviews.FlexItem
 
 	if len(m.state.cameras) > 0 {
@@ -187,7 +228,7 @@ func (m *model) View() string {
 	}
 
 	grid := buildCameraGrid(m.state)
-	items = append(items, bubbleviews.FlexItem{Node: grid})
+	items = append(items, bubbleviews.FlexItem{Node: grid, Grow: 1})
 
 	layout := bubbleviews.FlexNode{
 		Direction: bubbleviews.FlexDirectionColumn,
@@ -195,12 +236,11 @@ func (m *model) View() string {
 		Items:     items,
 	}
 
-	view := bubbleviews.View{
+	return bubbleviews.View{
 		Size:     bubbleviews.Size{Width: m.width, Height: m.height},
+		Overflow: bubbleviews.OverflowClip,
 		Children: []bubbleviews.Node{layout},
 	}
-
-	return render.Render(view)
 }
 
 func buildSummaryRow(state statusState) bubbleviews.Node {
@@ -219,7 +259,7 @@ func buildSummaryRow(state statusState) bubbleviews.Node {
 		{
 			Node: bubbleviews.TextNode{
 				Value:    "Streaming Recorder Service",
-				Bold:     true,
+				Style:    bubbleviews.TextStyle{Bold: true},
 				Wrap:     false,
 				Truncate: true,
 			},
@@ -227,7 +267,7 @@ func buildSummaryRow(state statusState) bubbleviews.Node {
 		{
 			Node: bubbleviews.TextNode{
 				Value:    statusLine,
-				Color:    bubbleviews.Color("36"),
+				Style:    bubbleviews.TextStyle{Color: bubbleviews.Color("36")},
 				Wrap:     false,
 				Truncate: true,
 			},
@@ -235,7 +275,7 @@ func buildSummaryRow(state statusState) bubbleviews.Node {
 		{
 			Node: bubbleviews.TextNode{
 				Value:    cameraCount,
-				Color:    bubbleviews.Color("63"),
+				Style:    bubbleviews.TextStyle{Color: bubbleviews.Color("63")},
 				Wrap:     false,
 				Truncate: true,
 			},
@@ -243,7 +283,7 @@ func buildSummaryRow(state statusState) bubbleviews.Node {
 		{
 			Node: bubbleviews.TextNode{
 				Value:    message,
-				Color:    bubbleviews.Color("244"),
+				Style:    bubbleviews.TextStyle{Color: bubbleviews.Color("244")},
 				Wrap:     false,
 				Truncate: true,
 			},
@@ -260,7 +300,7 @@ func buildSummaryRow(state statusState) bubbleviews.Node {
 			Children: []bubbleviews.Node{
 				bubbleviews.TextNode{
 					Value: "+ Add Camera",
-					Bold:  state.selected.inSummary,
+					Style: bubbleviews.TextStyle{Bold: state.selected.inSummary},
 				},
 			},
 		},
@@ -303,11 +343,11 @@ func buildCameraGrid(state statusState) bubbleviews.Node {
 				Children: []bubbleviews.Node{
 					bubbleviews.TextNode{
 						Value: "No active cameras detected.",
-						Color: bubbleviews.Color("244"),
+						Style: bubbleviews.TextStyle{Color: bubbleviews.Color("244")},
 					},
 					bubbleviews.TextNode{
 						Value: "Press TAB or Enter to add a camera.",
-						Color: bubbleviews.Color("62"),
+						Style: bubbleviews.TextStyle{Color: bubbleviews.Color("62")},
 					},
 					bubbleviews.BoxNode{
 						Style: bubbleviews.BoxStyle{
@@ -319,7 +359,7 @@ func buildCameraGrid(state statusState) bubbleviews.Node {
 							Children: []bubbleviews.Node{
 								bubbleviews.TextNode{
 									Value: "+ Add Camera",
-									Bold:  true,
+									Style: bubbleviews.TextStyle{Bold: true},
 								},
 							},
 						},
@@ -382,7 +422,14 @@ func buildCameraGrid(state statusState) bubbleviews.Node {
 			FillHeight:  true,
 		},
 		Content: bubbleviews.View{
-			Children: []bubbleviews.Node{gridContents},
+			Children: []bubbleviews.Node{bubbleviews.ScrollNode{
+				ID:             cameraScrollID,
+				Node:           gridContents,
+				OffsetY:        state.gridOffset,
+				Scrollbar:      true,
+				ScrollbarColor: bubbleviews.Color("238"),
+				ThumbColor:     bubbleviews.Color("63"),
+			}},
 		},
 	}
 }
@@ -390,7 +437,7 @@ func buildCameraGrid(state statusState) bubbleviews.Node {
 func buildCameraPanel(cam cameraStatus, focused bool) bubbleviews.Node {
 	header := bubbleviews.TextNode{
 		Value: cam.name,
-		Bold:  true,
+		Style: bubbleviews.TextStyle{Bold: true},
 	}
 
 	metrics := bubbleviews.FlexNode{
@@ -399,7 +446,7 @@ func buildCameraPanel(cam cameraStatus, focused bool) bubbleviews.Node {
 		Items: []bubbleviews.FlexItem{
 			{Node: bubbleviews.TextNode{Value: fmt.Sprintf("FPS: %d", cam.fps)}},
 			{Node: bubbleviews.TextNode{Value: fmt.Sprintf("Dropped frames: %d", cam.dropped)}},
-			{Node: bubbleviews.TextNode{Value: cam.lastMessage, Color: bubbleviews.Color("244"), Wrap: false, Truncate: true}},
+			{Node: bubbleviews.TextNode{Value: cam.lastMessage, Style: bubbleviews.TextStyle{Color: bubbleviews.Color("244")}, Wrap: false, Truncate: true}},
 		},
 	}
 
@@ -413,13 +460,14 @@ func buildCameraPanel(cam cameraStatus, focused bool) bubbleviews.Node {
 			Children: []bubbleviews.Node{
 				bubbleviews.TextNode{
 					Value: "Remove",
-					Bold:  focused,
+					Style: bubbleviews.TextStyle{Bold: focused},
 				},
 			},
 		},
 	}
 
 	return bubbleviews.BoxNode{
+		ID: cam.id,
 		Style: bubbleviews.BoxStyle{
 			Border:      bubbleviews.BorderThin,
 			BorderColor: bubbleviews.Color("63"),
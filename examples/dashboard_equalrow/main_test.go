@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
 
 func TestSnapshot(t *testing.T) {
 	m := newModel()
@@ -10,3 +14,41 @@ func TestSnapshot(t *testing.T) {
 	out := m.View()
 	t.Logf("\n%s", out)
 }
+
+func TestFocusedCameraScrollsIntoView(t *testing.T) {
+	m := newModel()
+	for range 4 {
+		m.addCamera()
+	}
+
+	// Sizing the window reveals the last camera added; shift+tab then walks
+	// focus back to the first.
+	shiftTab := tea.KeyMsg{Type: tea.KeyShiftTab}
+	steps := []struct {
+		msgs []tea.Msg
+		idx  int
+	}{
+		{msgs: []tea.Msg{tea.WindowSizeMsg{Width: 80, Height: 24}}, idx: 3},
+		{msgs: []tea.Msg{shiftTab, shiftTab, shiftTab}, idx: 0},
+	}
+	for _, step := range steps {
+		for _, msg := range step.msgs {
+			m.Update(msg)
+		}
+		idx := step.idx
+		if m.state.selected.cameraIdx != idx {
+			t.Fatalf("expected camera %d focused, got %d", idx, m.state.selected.cameraIdx)
+		}
+		m.View()
+
+		scroll, _ := m.layout.Find(cameraScrollID)
+		panel, ok := m.layout.Find(m.state.cameras[idx].id)
+		if !ok {
+			t.Fatalf("no layout for camera %d", idx)
+		}
+		bottom := panel.Rect.Y + panel.Rect.Height
+		if bottom > scroll.Content.Y+scroll.Content.Height || bottom <= scroll.Content.Y {
+			t.Fatalf("camera %d: expected its bottom row inside the viewport %v, got panel %v", idx, scroll.Content, panel.Rect)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+type platformStatus struct {
+	name    string
+	healthy bool
+	detail  string
+}
+
+var (
+	edgeRecorder   = platformStatus{name: "Edge Recorder", healthy: true, detail: "Capturing 12 streams across both loading docks with no dropped frames"}
+	cloudRelay     = platformStatus{name: "Cloud Relay", healthy: true, detail: "Uplink steady at 480 Mbps"}
+	reviewStations = platformStatus{name: "Review Stations", healthy: false, detail: "Station 3 lost its display adapter"}
+	archiveVault   = platformStatus{name: "Archive Vault", healthy: true, detail: "Cold storage tiering completed overnight for all April footage"}
+	alertRouter    = platformStatus{name: "Alert Router", healthy: true, detail: "Paging rotation synced"}
+	licenseServer  = platformStatus{name: "License Server", healthy: false, detail: "Seat count renewal due in 3 days"}
+	transcoder     = platformStatus{name: "Transcoder", healthy: true, detail: "H.265 queue drained"}
+)
+
+type model struct {
+	view  bubbleviews.View
+	ready bool
+}
+
+func newModel() model {
+	return model{}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.view = buildGridView(msg.Width, msg.Height)
+		m.ready = true
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+
+	return render.Render(m.view)
+}
+
+func main() {
+	p := tea.NewProgram(newModel(), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func buildGridView(width, height int) bubbleviews.View {
+	grid := bubbleviews.EvenRowGrid{
+		Items: []bubbleviews.EvenRowItem{
+			{Node: buildStatusCard(edgeRecorder), WidthPercent: 50},
+			{Node: buildStatusCard(cloudRelay), WidthPercent: 25},
+			{Node: buildStatusCard(reviewStations), WidthPercent: 25},
+			{Node: buildStatusCard(archiveVault), WidthPercent: 50},
+			{Node: buildStatusCard(alertRouter), WidthPercent: 25},
+			{Node: buildStatusCard(licenseServer), WidthPercent: 25},
+			{Node: buildStatusCard(transcoder), WidthPercent: 50},
+		},
+		MaxPerRow:  3,
+		Spacing:    2,
+		RowSpacing: 1,
+		RowHeight:  6,
+	}.Node()
+
+	return bubbleviews.View{
+		Size: bubbleviews.Size{Width: width, Height: height},
+		Children: []bubbleviews.Node{
+			bubbleviews.BoxNode{
+				Style: bubbleviews.BoxStyle{
+					Border:      bubbleviews.BorderThick,
+					BorderColor: bubbleviews.Color("63"),
+					Padding:     bubbleviews.Padding{Top: 1, Bottom: 1, Left: 2, Right: 2},
+					FillWidth:   true,
+					FillHeight:  true,
+				},
+				Content: bubbleviews.View{
+					Children: []bubbleviews.Node{grid},
+				},
+			},
+		},
+	}
+}
+
+func buildStatusCard(status platformStatus) bubbleviews.Node {
+	stateLabel := "Healthy"
+	stateColor := bubbleviews.Color("36")
+	borderColor := bubbleviews.Color("63")
+	if !status.healthy {
+		stateLabel = "Needs attention"
+		stateColor = bubbleviews.Color("203")
+		borderColor = bubbleviews.Color("203")
+	}
+
+	return bubbleviews.BoxNode{
+		Style: bubbleviews.BoxStyle{
+			Border:      bubbleviews.BorderThin,
+			BorderColor: borderColor,
+			Padding:     bubbleviews.Padding{Left: 1, Right: 1},
+			FillWidth:   true,
+			FillHeight:  true,
+		},
+		Content: bubbleviews.View{
+			Children: []bubbleviews.Node{
+				bubbleviews.TextNode{
+					Value:    status.name,
+					Style:    bubbleviews.TextStyle{Bold: true},
+					Truncate: true,
+				},
+				bubbleviews.TextNode{
+					Value:    fmt.Sprintf("Status: %s", stateLabel),
+					Style:    bubbleviews.TextStyle{Color: stateColor},
+					Truncate: true,
+				},
+				bubbleviews.TextNode{
+					Value:    status.detail,
+					Style:    bubbleviews.TextStyle{Color: bubbleviews.Color("244")},
+					Truncate: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+// gridRows returns the rows EvenRowGrid laid out inside the example's frame.
+func gridRows(laid render.LayoutTree) []render.LayoutNode {
+	return laid.Nodes[0].Children[0].Children
+}
+
+func TestRowsShareHeightAndColumns(t *testing.T) {
+	laid := render.Layout(buildGridView(80, 30))
+	t.Logf("\n%s", render.Paint(laid))
+
+	rows := gridRows(laid)
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+
+	var firstColumns string
+	for i, row := range rows {
+		if row.Rect.Height != 6 {
+			t.Fatalf("row %d: expected height 6, got %d", i, row.Rect.Height)
+		}
+
+		var columns []int
+		for _, cell := range row.Children {
+			if _, card := cell.Node.(bubbleviews.BoxNode); card && cell.Rect.Height != 6 {
+				t.Fatalf("row %d: expected every card stretched to 6 rows, got %d", i, cell.Rect.Height)
+			}
+			columns = append(columns, cell.Rect.X, cell.Rect.Width)
+		}
+		if i == 0 {
+			firstColumns = fmt.Sprint(columns)
+			continue
+		}
+		if fmt.Sprint(columns) != firstColumns {
+			t.Fatalf("row %d: expected columns %s, got %v", i, firstColumns, columns)
+		}
+	}
+}
+
+func TestLaterRowsIgnoreWidthPercent(t *testing.T) {
+	card := bubbleviews.BoxNode{Style: bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, FillWidth: true}}
+	grid := bubbleviews.EvenRowGrid{
+		Items: []bubbleviews.EvenRowItem{
+			{Node: card, WidthPercent: 50},
+			{Node: card, WidthPercent: 50},
+			{Node: card, WidthPercent: 25},
+			{Node: card, WidthPercent: 75},
+		},
+		MaxPerRow: 2,
+	}.Node()
+
+	laid := render.Layout(bubbleviews.View{
+		Size:     bubbleviews.Size{Width: 40},
+		Children: []bubbleviews.Node{grid},
+	})
+
+	for i, row := range laid.Nodes[0].Children {
+		var columns []int
+		for _, cell := range row.Children {
+			columns = append(columns, cell.Rect.X, cell.Rect.Width)
+		}
+		if want := []int{0, 20, 20, 20}; fmt.Sprint(columns) != fmt.Sprint(want) {
+			t.Fatalf("row %d: expected columns %v, got %v", i, want, columns)
+		}
+	}
+}
+
+func TestRowsSplitParentHeightWithoutRowHeight(t *testing.T) {
+	card := bubbleviews.BoxNode{Style: bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, FillWidth: true, FillHeight: true}}
+	grid := bubbleviews.EvenRowGrid{
+		Items:      []bubbleviews.EvenRowItem{{Node: card}, {Node: card}, {Node: card}},
+		MaxPerRow:  1,
+		RowSpacing: 1,
+	}.Node()
+
+	// Twelve rows less two gaps leave ten for three rows: 4, 3 and 3.
+	laid := render.Layout(bubbleviews.View{
+		Size:     bubbleviews.Size{Width: 20, Height: 12},
+		Children: []bubbleviews.Node{grid},
+	})
+
+	var heights, cards []int
+	for _, row := range laid.Nodes[0].Children {
+		heights = append(heights, row.Rect.Height)
+		cards = append(cards, row.Children[0].Rect.Height)
+	}
+	if want := []int{4, 3, 3}; fmt.Sprint(heights) != fmt.Sprint(want) || fmt.Sprint(cards) != fmt.Sprint(want) {
+		t.Fatalf("expected rows and cards %v tall, got rows %v and cards %v", want, heights, cards)
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+type model struct {
+	view  bubbleviews.View
+	ready bool
+}
+
+func newModel() model {
+	return model{}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.view = buildGridView(msg.Width, msg.Height)
+		m.ready = true
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+
+	return render.Render(m.view)
+}
+
+func main() {
+	p := tea.NewProgram(newModel(), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func buildGridView(width, height int) bubbleviews.View {
+	grid := bubbleviews.GridNode{
+		Columns:   []bubbleviews.Dimension{bubbleviews.Fraction(1), bubbleviews.Fraction(1), bubbleviews.Cells(24)},
+		Rows:      []bubbleviews.Dimension{{}, bubbleviews.Fraction(1), bubbleviews.Fraction(1), bubbleviews.Fraction(1)},
+		ColumnGap: 2,
+		RowGap:    1,
+		Cells: []bubbleviews.GridCell{
+			{
+				Node: bubbleviews.TextNode{
+					Value: "Ingest Overview",
+					Style: bubbleviews.TextStyle{Color: bubbleviews.Color("63"), Bold: true},
+				},
+				ColSpan: 3,
+			},
+			{
+				Node:    buildPanel("Throughput (last hour)", buildChart(), bubbleviews.Color("69")),
+				Row:     1,
+				ColSpan: 2,
+				RowSpan: 2,
+			},
+			{Node: buildMetricTile("Streams", "12 live"), Row: 1, Column: 2},
+			{Node: buildMetricTile("Dropped frames", "0.02%"), Row: 2, Column: 2},
+			{Node: buildMetricTile("Storage", "61% used"), Row: 3, Column: 2},
+			{
+				Node: buildPanel("Recent events", bubbleviews.ListView{
+					Items: []string{
+						"Dock camera 4 reconnected",
+						"Nightly archive finished",
+					},
+				}.Node(), bubbleviews.Color("108")),
+				Row:     3,
+				ColSpan: 2,
+			},
+		},
+	}
+
+	return bubbleviews.View{
+		Size: bubbleviews.Size{Width: width, Height: height},
+		Children: []bubbleviews.Node{
+			bubbleviews.BoxNode{
+				Style: bubbleviews.BoxStyle{
+					Border:      bubbleviews.BorderThick,
+					BorderColor: bubbleviews.Color("63"),
+					Padding:     bubbleviews.Padding{Top: 1, Bottom: 1, Left: 2, Right: 2},
+					FillWidth:   true,
+					FillHeight:  true,
+				},
+				Content: bubbleviews.View{
+					Children: []bubbleviews.Node{grid},
+				},
+			},
+		},
+	}
+}
+
+func buildChart() bubbleviews.Node {
+	return bubbleviews.ASCIIArtNode{
+		Lines: []string{
+			"        ▂▄▆█▆▄",
+			"   ▂▄▆██████████▆▄▂",
+			"▄▆██████████████████▆▄",
+		},
+		Style: bubbleviews.TextStyle{Color: bubbleviews.Color("69")},
+	}
+}
+
+func buildMetricTile(label, value string) bubbleviews.Node {
+	return buildPanel(label, bubbleviews.TextNode{
+		Value: value,
+		Style: bubbleviews.TextStyle{Bold: true},
+	}, bubbleviews.Color("240"))
+}
+
+func buildPanel(title string, body bubbleviews.Node, color bubbleviews.Color) bubbleviews.Node {
+	return bubbleviews.BoxNode{
+		Style: bubbleviews.BoxStyle{
+			Border:      bubbleviews.BorderThin,
+			BorderColor: color,
+			Padding:     bubbleviews.Padding{Left: 1, Right: 1},
+			FillWidth:   true,
+			FillHeight:  true,
+		},
+		Content: bubbleviews.View{
+			Children: []bubbleviews.Node{
+				bubbleviews.TextNode{
+					Value:    title,
+					Style:    bubbleviews.TextStyle{Color: color},
+					Truncate: true,
+				},
+				body,
+			},
+		},
+	}
+}
@@ -16,10 +16,17 @@ type model struct {
 	clickMessage string
 }
 
-var commands = []string{
-	"Add camera column",
-	"Remove focused camera",
-	"Toggle debug overlay",
+type command struct {
+	label      string
+	disabled   bool // shown faint and cannot be fired
+	deprecated bool // still works, shown struck through
+}
+
+var commands = []command{
+	{label: "Add camera column"},
+	{label: "Remove focused camera"},
+	{label: "Toggle debug overlay", disabled: true},
+	{label: "Export legacy report", deprecated: true},
 }
 
 func newModel() model {
@@ -50,7 +57,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selected++
 			}
 		case "enter":
-			m.clickMessage = fmt.Sprintf("Command executed: %s", commands[m.selected])
+			cmd := commands[m.selected]
+			if cmd.disabled {
+				m.clickMessage = fmt.Sprintf("%s is disabled.", cmd.label)
+				break
+			}
+			m.clickMessage = fmt.Sprintf("Command executed: %s", cmd.label)
 		}
 	}
 
@@ -73,6 +85,7 @@ func buildHeaderRow() bubbleviews.Node {
 	return bubbleviews.BoxNode{
 		Style: bubbleviews.BoxStyle{
 			Border:      bubbleviews.BorderThick,
+			BorderSides: bubbleviews.BorderSides{Bottom: true},
 			BorderColor: bubbleviews.Color("63"),
 			FillWidth:   true,
 		},
@@ -80,11 +93,11 @@ func buildHeaderRow() bubbleviews.Node {
 			Children: []bubbleviews.Node{
 				bubbleviews.TextNode{
 					Value: "Interactive Controls Demo",
-					Bold:  true,
+					Style: bubbleviews.TextStyle{Bold: true},
 				},
 				bubbleviews.TextNode{
 					Value: "Use ↑/↓ to change selection. Press enter to fire the focused command. q to quit.",
-					Color: bubbleviews.Color("244"),
+					Style: bubbleviews.TextStyle{Color: bubbleviews.Color("244")},
 				},
 			},
 		},
@@ -96,16 +109,19 @@ func buildCommandList(m model) bubbleviews.Node {
 
 	for i, cmd := range commands {
 		isSelected := i == m.selected
-		borderColor := bubbleviews.Color("240")
+		// Only the selected command shows its accent bar; the others keep
+		// the column blank so labels stay aligned.
+		accent := bubbleviews.BorderHidden
 		if isSelected {
-			borderColor = bubbleviews.Color("205")
+			accent = bubbleviews.BorderThick
 		}
 
 		item := bubbleviews.FlexItem{
 			Node: bubbleviews.BoxNode{
 				Style: bubbleviews.BoxStyle{
-					Border:      bubbleviews.BorderThin,
-					BorderColor: borderColor,
+					Border:      accent,
+					BorderSides: bubbleviews.BorderSides{Left: true},
+					BorderColor: bubbleviews.Color("205"),
 					Padding: bubbleviews.Padding{
 						Left:   2,
 						Right:  2,
@@ -117,8 +133,12 @@ func buildCommandList(m model) bubbleviews.Node {
 				Content: bubbleviews.View{
 					Children: []bubbleviews.Node{
 						bubbleviews.TextNode{
-							Value: cmd,
-							Bold:  isSelected,
+							Value: cmd.label,
+							Style: bubbleviews.TextStyle{
+								Bold:          isSelected,
+								Faint:         cmd.disabled,
+								Strikethrough: cmd.deprecated,
+							},
 						},
 					},
 				},
@@ -131,7 +151,7 @@ func buildCommandList(m model) bubbleviews.Node {
 	items = append(items, bubbleviews.FlexItem{
 		Node: bubbleviews.TextNode{
 			Value: m.clickMessage,
-			Color: bubbleviews.Color("36"),
+			Style: bubbleviews.TextStyle{Color: bubbleviews.Color("36")},
 		},
 	})
 
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/input"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+const notesID = "release-notes"
+
+const releaseNotes = `# Recorder 2.4
+
+Recorder 2.4 focuses on **multi-site setups** and makes the dashboard easier
+to read from across the room. See the [upgrade guide](https://example.com/upgrade)
+before updating a cluster.
+
+## Highlights
+
+- Cameras can be grouped by _site_, and each group keeps its own retention.
+- The dashboard tiles now share borders, so a wall display fits
+  **two more cameras** per row.
+- Clips are exported in the background:
+  - progress shows in the status bar
+  - failed exports retry up to three times
+- ~~Legacy MJPEG streams~~ are no longer supported.
+
+## Upgrading
+
+1. Stop the recorder with ` + "`recorder stop`" + `.
+2. Back up ` + "`/etc/recorder`" + `.
+3. Install the new package and start the service again.
+
+> Existing retention rules move to the default site. Review them after the
+> upgrade, since storage limits are now enforced **per site**.
+
+` + "```" + `sh
+recorder stop
+cp -r /etc/recorder /etc/recorder.bak
+recorder migrate --sites
+recorder start
+` + "```" + `
+
+---
+
+## Keyboard shortcuts
+
+| Key | Action | Notes |
+| :-- | :----: | :---- |
+| ` + "`a`" + ` | Add camera | Prompts for the stream URL |
+| ` + "`x`" + ` | Remove camera | Asks for confirmation first |
+| ` + "`/`" + ` | Search | Filters cameras and sites by name |
+| ` + "`q`" + ` | Quit | |
+
+Questions? Open an issue at <https://example.com/recorder/issues>.`
+
+type model struct {
+	width  int
+	height int
+	offset int
+	layout render.LayoutTree
+	mouse  input.Router
+}
+
+func newModel() *model {
+	m := &model{}
+	m.mouse.OnWheel(notesID, func(e input.Event) tea.Cmd {
+		switch e.Mouse.Button {
+		case tea.MouseButtonWheelDown:
+			m.scrollBy(3)
+		case tea.MouseButtonWheelUp:
+			m.scrollBy(-3)
+		}
+		return nil
+	})
+	return m
+}
+
+func (m *model) Init() tea.Cmd {
+	return nil
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case tea.MouseMsg:
+		cmd, _ := m.mouse.Route(m.layout, msg)
+		return m, cmd
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "down", "j":
+			m.scrollBy(1)
+		case "up", "k":
+			m.scrollBy(-1)
+		case "pgdown", " ":
+			m.scrollBy(m.pageSize())
+		case "pgup":
+			m.scrollBy(-m.pageSize())
+		case "home", "g":
+			m.offset = 0
+		case "end", "G":
+			m.scrollBy(1 << 20)
+		}
+	}
+
+	return m, nil
+}
+
+// scrollBy moves the notes and clamps the offset against the extent reported
+// by the most recent layout, which changes as the terminal is resized and the
+// document rewraps.
+func (m *model) scrollBy(delta int) {
+	m.offset += delta
+	if node, ok := m.layout.Find(notesID); ok {
+		if content, viewport, ok := node.ScrollExtent(); ok {
+			m.offset = bubbleviews.ClampScroll(m.offset, content.Height, viewport.Height)
+		}
+	}
+	m.offset = max(m.offset, 0)
+}
+
+func (m *model) pageSize() int {
+	if node, ok := m.layout.Find(notesID); ok {
+		return max(node.Content.Height-1, 1)
+	}
+	return 1
+}
+
+func (m *model) View() string {
+	m.layout = render.Layout(buildMarkdownView(m.width, m.height, m.offset))
+	return render.Paint(m.layout)
+}
+
+func buildMarkdownView(width, height, offset int) bubbleviews.View {
+	notes := bubbleviews.ScrollNode{
+		ID:             notesID,
+		Node:           bubbleviews.MarkdownNode{Source: releaseNotes},
+		OffsetY:        offset,
+		Scrollbar:      true,
+		ScrollbarColor: bubbleviews.Color("238"),
+		ThumbColor:     bubbleviews.Color("69"),
+	}
+
+	return bubbleviews.View{
+		Size:     bubbleviews.Size{Width: width, Height: height},
+		Overflow: bubbleviews.OverflowClip,
+		Children: []bubbleviews.Node{
+			bubbleviews.BoxNode{
+				Style: bubbleviews.BoxStyle{
+					Border:      bubbleviews.BorderRounded,
+					BorderColor: bubbleviews.Color("63"),
+					Padding:     bubbleviews.Padding{Left: 1},
+					FillWidth:   true,
+					FillHeight:  true,
+					Title:       "Release notes",
+					Footer:      "↑/↓ scroll · pgup/pgdn page · q quits",
+					FooterAlign: bubbleviews.AlignEnd,
+					FooterStyle: bubbleviews.TextStyle{Color: bubbleviews.Color("244")},
+				},
+				Content: bubbleviews.View{Children: []bubbleviews.Node{notes}},
+			},
+		},
+	}
+}
+
+func main() {
+	p := tea.NewProgram(newModel(), tea.WithAltScreen(), tea.WithMouseCellMotion())
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/input"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+const eventLogID = "event-log"
+
+var cameras = []string{"Dock 1", "Dock 2", "Lobby", "Parking", "Warehouse"}
+
+var eventKinds = []string{
+	"motion detected",
+	"stream reconnected",
+	"clip archived",
+	"frame drop above threshold",
+	"operator acknowledged alert",
+}
+
+type model struct {
+	width  int
+	height int
+	events []string
+	offset int
+	layout render.LayoutTree
+	mouse  input.Router
+}
+
+func newModel() *model {
+	m := &model{events: sampleEvents(60)}
+	m.mouse.OnWheel(eventLogID, func(e input.Event) tea.Cmd {
+		switch e.Mouse.Button {
+		case tea.MouseButtonWheelDown:
+			m.scrollBy(3)
+		case tea.MouseButtonWheelUp:
+			m.scrollBy(-3)
+		}
+		return nil
+	})
+	return m
+}
+
+func sampleEvents(count int) []string {
+	events := make([]string, count)
+	for i := range events {
+		events[i] = fmt.Sprintf(
+			"%02d:%02d %s: %s",
+			8+i/12, (i*5)%60,
+			cameras[i%len(cameras)],
+			eventKinds[(i*3)%len(eventKinds)],
+		)
+	}
+	return events
+}
+
+func (m *model) Init() tea.Cmd {
+	return nil
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case tea.MouseMsg:
+		cmd, _ := m.mouse.Route(m.layout, msg)
+		return m, cmd
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "down", "j":
+			m.scrollBy(1)
+		case "up", "k":
+			m.scrollBy(-1)
+		case "pgdown", " ":
+			m.scrollBy(m.pageSize())
+		case "pgup":
+			m.scrollBy(-m.pageSize())
+		case "home", "g":
+			m.offset = 0
+		case "end", "G":
+			m.scrollBy(len(m.events))
+		}
+	}
+
+	return m, nil
+}
+
+// scrollBy moves the log and clamps the offset against the extent reported by
+// the most recent layout.
+func (m *model) scrollBy(delta int) {
+	m.offset += delta
+	if node, ok := m.layout.Find(eventLogID); ok {
+		if content, viewport, ok := node.ScrollExtent(); ok {
+			m.offset = bubbleviews.ClampScroll(m.offset, content.Height, viewport.Height)
+		}
+	}
+	m.offset = max(m.offset, 0)
+}
+
+func (m *model) pageSize() int {
+	if node, ok := m.layout.Find(eventLogID); ok {
+		return max(node.Content.Height-1, 1)
+	}
+	return 1
+}
+
+func (m *model) View() string {
+	m.layout = render.Layout(buildScrollView(m.width, m.height, m.events, m.offset))
+	return render.Paint(m.layout)
+}
+
+func buildScrollView(width, height int, events []string, offset int) bubbleviews.View {
+	eventLog := bubbleviews.ScrollNode{
+		ID: eventLogID,
+		Node: bubbleviews.ListView{
+			ItemColor: bubbleviews.Color("252"),
+			Bullet:    "• ",
+			Items:     events,
+		}.Node(),
+		OffsetY:        offset,
+		Scrollbar:      true,
+		ScrollbarColor: bubbleviews.Color("238"),
+		ThumbColor:     bubbleviews.Color("69"),
+	}
+
+	return bubbleviews.View{
+		Size:     bubbleviews.Size{Width: width, Height: height},
+		Overflow: bubbleviews.OverflowClip,
+		Children: []bubbleviews.Node{
+			bubbleviews.BoxNode{
+				Style: bubbleviews.BoxStyle{
+					Border:      bubbleviews.BorderThick,
+					BorderColor: bubbleviews.Color("63"),
+					Padding:     bubbleviews.Padding{Top: 1, Bottom: 1, Left: 2, Right: 2},
+					FillWidth:   true,
+					FillHeight:  true,
+				},
+				Content: bubbleviews.View{
+					Children: []bubbleviews.Node{
+						bubbleviews.FlexNode{
+							Direction: bubbleviews.FlexDirectionColumn,
+							Spacing:   1,
+							Items: []bubbleviews.FlexItem{
+								{Node: bubbleviews.TextNode{Value: "Camera Event Log", Style: bubbleviews.TextStyle{Bold: true}}},
+								{Node: bubbleviews.TextNode{
+									Value: "↑/↓ or the mouse wheel scroll · pgup/pgdn page · home/end jump · q quits",
+									Style: bubbleviews.TextStyle{Color: bubbleviews.Color("244")},
+									Wrap:  true,
+								}},
+								{Node: eventLog, Grow: 1},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func main() {
+	p := tea.NewProgram(newModel(), tea.WithAltScreen(), tea.WithMouseCellMotion())
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestSnapshot(t *testing.T) {
+	m := newModel()
+	m.width = 80
+	m.height = 24
+	m.View()
+	m.scrollBy(1000)
+	out := m.View()
+	t.Logf("\n%s", out)
+}
+
+// thumbRows returns the viewport rows the scrollbar thumb covers.
+func thumbRows(t *testing.T, m *model, out string) []int {
+	t.Helper()
+	node, ok := m.layout.Find(eventLogID)
+	if !ok {
+		t.Fatal("no layout for the event log")
+	}
+
+	lines := strings.Split(ansi.Strip(out), "\n")
+	column := node.Rect.X + node.Rect.Width - 1
+	var rows []int
+	for y := range node.Rect.Height {
+		if []rune(lines[node.Rect.Y+y])[column] == '┃' {
+			rows = append(rows, y)
+		}
+	}
+	return rows
+}
+
+func TestScrollClampsOffsetAndMovesThumb(t *testing.T) {
+	// Sixty events in a sixteen-row viewport: the offset stops at 44 and the
+	// thumb is four rows tall.
+	tests := []struct {
+		name   string
+		scroll func(m *model)
+		offset int
+		thumb  []int
+	}{
+		{name: "start", scroll: func(*model) {}, offset: 0, thumb: []int{0, 1, 2, 3}},
+		{name: "past the end", scroll: func(m *model) { m.scrollBy(1000) }, offset: 44, thumb: []int{12, 13, 14, 15}},
+		{name: "before the start", scroll: func(m *model) { m.scrollBy(-5) }, offset: 0, thumb: []int{0, 1, 2, 3}},
+		{name: "middle", scroll: func(m *model) { m.scrollBy(22) }, offset: 22, thumb: []int{6, 7, 8, 9}},
+		{name: "end key", scroll: func(m *model) { m.Update(tea.KeyMsg{Type: tea.KeyEnd}) }, offset: 44, thumb: []int{12, 13, 14, 15}},
+		{name: "wheel", scroll: func(m *model) {
+			node, _ := m.layout.Find(eventLogID)
+			m.Update(tea.MouseMsg{X: node.Rect.X, Y: node.Rect.Y, Action: tea.MouseActionPress, Button: tea.MouseButtonWheelDown})
+		}, offset: 3, thumb: []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newModel()
+			m.width = 80
+			m.height = 24
+			m.View()
+
+			tt.scroll(m)
+			if m.offset != tt.offset {
+				t.Fatalf("expected offset %d, got %d", tt.offset, m.offset)
+			}
+			if got := thumbRows(t, m, m.View()); fmt.Sprint(got) != fmt.Sprint(tt.thumb) {
+				t.Fatalf("expected the thumb on rows %v, got %v", tt.thumb, got)
+			}
+		})
+	}
+}
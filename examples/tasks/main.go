@@ -5,26 +5,46 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/input"
 	"github.com/sprucelabsai-community/bubbleviews/render"
 )
 
+const columnsID = "task-columns"
+
 type model struct {
-	view  bubbleviews.View
-	ready bool
+	width   int
+	height  int
+	ready   bool
+	ratio   float64
+	layout  render.LayoutTree
+	resizer input.SplitResizer
 }
 
-func newModel() model {
-	return model{}
+func newModel() *model {
+	return &model{
+		ratio: 0.5,
+		resizer: input.SplitResizer{
+			ID:     columnsID,
+			Shrink: []string{"left", "h"},
+			Grow:   []string{"right", "l"},
+		},
+	}
 }
 
-func (m model) Init() tea.Cmd {
+func (m *model) Init() tea.Cmd {
 	return nil
 }
 
-func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if ratio, changed := m.resizer.Update(m.layout, m.ratio, msg); changed {
+		m.ratio = ratio
+		return m, nil
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.view = buildTasksView(msg.Width, msg.Height)
+		m.width = msg.Width
+		m.height = msg.Height
 		m.ready = true
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -36,22 +56,23 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-func (m model) View() string {
+func (m *model) View() string {
 	if !m.ready {
 		return "loading..."
 	}
 
-	return render.Render(m.view)
+	m.layout = render.Layout(buildTasksView(m.width, m.height, m.ratio))
+	return render.Paint(m.layout)
 }
 
 func main() {
-	p := tea.NewProgram(newModel(), tea.WithAltScreen())
+	p := tea.NewProgram(newModel(), tea.WithAltScreen(), tea.WithMouseCellMotion())
 	if _, err := p.Run(); err != nil {
 		log.Fatal(err)
 	}
 }
 
-func buildTasksView(width, height int) bubbleviews.View {
+func buildTasksView(width, height int, ratio float64) bubbleviews.View {
 	outstandingList := bubbleviews.ListView{
 		Title:      "Outstanding Tasks",
 		TitleColor: bubbleviews.Color("69"),
@@ -75,57 +96,60 @@ func buildTasksView(width, height int) bubbleviews.View {
 		},
 	}.Node()
 
-	columns := bubbleviews.FlexNode{
-		Direction: bubbleviews.FlexDirectionRow,
-		Spacing:   4,
-		Items: []bubbleviews.FlexItem{
-			{
-				Node: bubbleviews.BoxNode{
-					Style: bubbleviews.BoxStyle{
-						Border:      bubbleviews.BorderThin,
-						BorderColor: bubbleviews.Color("69"),
-						Padding: bubbleviews.Padding{
-							Top:    1,
-							Bottom: 1,
-							Left:   2,
-							Right:  2,
-						},
-						FillWidth:  true,
-						FillHeight: true,
-					},
-					Content: bubbleviews.View{
-						Children: []bubbleviews.Node{
-							outstandingList,
-						},
-					},
+	columns := bubbleviews.SplitNode{
+		ID:           columnsID,
+		Direction:    bubbleviews.FlexDirectionRow,
+		Ratio:        ratio,
+		MinFirst:     20,
+		MinSecond:    20,
+		DividerColor: bubbleviews.Color("240"),
+		First: bubbleviews.BoxNode{
+			Style: bubbleviews.BoxStyle{
+				Border:      bubbleviews.BorderThin,
+				BorderColor: bubbleviews.Color("69"),
+				Padding: bubbleviews.Padding{
+					Top:    1,
+					Bottom: 1,
+					Left:   2,
+					Right:  2,
 				},
+				Margin:     bubbleviews.Padding{Right: 1},
+				FillWidth:  true,
+				FillHeight: true,
+				Overflow:   bubbleviews.OverflowEllipsisRow,
 			},
-			{
-				Node: bubbleviews.BoxNode{
-					Style: bubbleviews.BoxStyle{
-						Border:      bubbleviews.BorderThin,
-						BorderColor: bubbleviews.Color("108"),
-						Padding: bubbleviews.Padding{
-							Top:    1,
-							Bottom: 1,
-							Left:   2,
-							Right:  2,
-						},
-						FillWidth:  true,
-						FillHeight: true,
-					},
-					Content: bubbleviews.View{
-						Children: []bubbleviews.Node{
-							completedList,
-						},
-					},
+			Content: bubbleviews.View{
+				Children: []bubbleviews.Node{
+					outstandingList,
+				},
+			},
+		},
+		Second: bubbleviews.BoxNode{
+			Style: bubbleviews.BoxStyle{
+				Border:      bubbleviews.BorderThin,
+				BorderColor: bubbleviews.Color("108"),
+				Padding: bubbleviews.Padding{
+					Top:    1,
+					Bottom: 1,
+					Left:   2,
+					Right:  2,
+				},
+				Margin:     bubbleviews.Padding{Left: 1},
+				FillWidth:  true,
+				FillHeight: true,
+				Overflow:   bubbleviews.OverflowEllipsisRow,
+			},
+			Content: bubbleviews.View{
+				Children: []bubbleviews.Node{
+					completedList,
 				},
 			},
 		},
 	}
 
 	return bubbleviews.View{
-		Size: bubbleviews.Size{Width: width, Height: height},
+		Size:     bubbleviews.Size{Width: width, Height: height},
+		Overflow: bubbleviews.OverflowClip,
 		Children: []bubbleviews.Node{
 			bubbleviews.BoxNode{
 				Style: bubbleviews.BoxStyle{
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+type model struct {
+	view  bubbleviews.View
+	ready bool
+}
+
+func newModel() model {
+	return model{}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.view = buildTilesView(msg.Width, msg.Height)
+		m.ready = true
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+
+	return render.Render(m.view)
+}
+
+func main() {
+	p := tea.NewProgram(newModel(), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func buildTilesView(width, height int) bubbleviews.View {
+	status := bubbleviews.FlexNode{
+		CollapseBorders: true,
+		AlignItems:      bubbleviews.FlexAlignStretch,
+		Items: []bubbleviews.FlexItem{
+			{Node: buildMetricTile("Streams", "12 live")},
+			{Node: buildMetricTile("Dropped frames", "0.02%")},
+			{Node: buildMetricTile("Storage", "61% used")},
+			{Node: buildMetricTile("Alerts", "none")},
+		},
+	}
+
+	panels := bubbleviews.GridNode{
+		CollapseBorders: true,
+		Columns:         []bubbleviews.Dimension{bubbleviews.Fraction(2), bubbleviews.Fraction(1)},
+		Rows:            []bubbleviews.Dimension{bubbleviews.Fraction(1), bubbleviews.Fraction(1)},
+		Cells: []bubbleviews.GridCell{
+			{Node: buildPanel("Throughput", buildChart()), RowSpan: 2},
+			{Node: buildPanel("Ingest nodes", bubbleviews.ListView{
+				Items: []string{"ingest-01 healthy", "ingest-02 healthy", "ingest-03 draining"},
+			}.Node()), Column: 1},
+			{Node: buildPanel("Recent events", bubbleviews.ListView{
+				Items: []string{"Dock camera 4 reconnected", "Nightly archive finished"},
+			}.Node()), Row: 1, Column: 1},
+		},
+	}
+
+	return bubbleviews.View{
+		Size:     bubbleviews.Size{Width: width, Height: height},
+		Overflow: bubbleviews.OverflowClip,
+		Children: []bubbleviews.Node{
+			bubbleviews.FlexNode{
+				Direction:       bubbleviews.FlexDirectionColumn,
+				CollapseBorders: true,
+				Items: []bubbleviews.FlexItem{
+					{Node: status},
+					{Node: panels, Grow: 1},
+				},
+			},
+		},
+	}
+}
+
+func buildChart() bubbleviews.Node {
+	return bubbleviews.ASCIIArtNode{
+		Lines: []string{
+			"        ▂▄▆█▆▄",
+			"   ▂▄▆██████████▆▄▂",
+			"▄▆██████████████████▆▄",
+		},
+		Style: bubbleviews.TextStyle{Color: bubbleviews.Color("69")},
+	}
+}
+
+func buildMetricTile(label, value string) bubbleviews.Node {
+	return buildPanel(label, bubbleviews.TextNode{
+		Value: value,
+		Style: bubbleviews.TextStyle{Bold: true},
+	})
+}
+
+// buildPanel frames body in a box that fills its slot, so neighbouring panels
+// line up on the borders they share.
+func buildPanel(title string, body bubbleviews.Node) bubbleviews.Node {
+	return bubbleviews.BoxNode{
+		Style: bubbleviews.BoxStyle{
+			Border:      bubbleviews.BorderThin,
+			BorderColor: bubbleviews.Color("63"),
+			Padding:     bubbleviews.Padding{Left: 1, Right: 1},
+			FillWidth:   true,
+			FillHeight:  true,
+			Overflow:    bubbleviews.OverflowClip,
+			Title:       title,
+		},
+		Content: bubbleviews.View{
+			Children: []bubbleviews.Node{body},
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+func TestTilesShareBorders(t *testing.T) {
+	out := render.Render(buildTilesView(80, 20))
+	t.Logf("\n%s", out)
+
+	lines := strings.Split(out, "\n")
+	if strings.Contains(out, "││") || strings.Contains(out, "┐┌") {
+		t.Fatalf("expected neighbouring tiles to share borders")
+	}
+	if !strings.Contains(lines[0], "┬") {
+		t.Fatalf("expected ┬ where status tiles meet, got %q", lines[0])
+	}
+	if !strings.Contains(out, "┼") && !strings.Contains(out, "┤") {
+		t.Fatalf("expected junctions where the panel grid meets the status row")
+	}
+}
@@ -0,0 +1,51 @@
+// Package input connects Bubble Tea mouse messages to the nodes of a rendered
+// view. Hit-testing runs against the render.LayoutTree the view was painted
+// from, so the tree must use the same coordinates as the terminal: lay the
+// view out at the screen's size and paint it from the top-left corner, as a
+// full-window program using tea.WithAltScreen does.
+package input
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+// Hit describes the identified nodes under the mouse cursor. Nodes without an
+// ID are skipped, so ID names the deepest node that has one.
+type Hit struct {
+	ID        string
+	Rect      render.Rect // where the node identified by ID landed
+	Ancestors []string    // IDs of the enclosing nodes, nearest first
+}
+
+// HitTest finds the nodes under msg's cursor. It reports false when no node
+// with an ID sits there.
+func HitTest(tree render.LayoutTree, msg tea.MouseMsg) (Hit, bool) {
+	path := identified(tree.At(msg.X, msg.Y))
+	if len(path) == 0 {
+		return Hit{}, false
+	}
+
+	deepest := path[len(path)-1]
+	hit := Hit{
+		ID:        bubbleviews.NodeID(deepest.Node),
+		Rect:      deepest.Rect,
+		Ancestors: make([]string, 0, len(path)-1),
+	}
+	for i := len(path) - 2; i >= 0; i-- {
+		hit.Ancestors = append(hit.Ancestors, bubbleviews.NodeID(path[i].Node))
+	}
+	return hit, true
+}
+
+// identified keeps the nodes on path that carry an ID.
+func identified(path []render.LayoutNode) []render.LayoutNode {
+	kept := path[:0:0]
+	for _, node := range path {
+		if bubbleviews.NodeID(node.Node) != "" {
+			kept = append(kept, node)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,92 @@
+package input
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+// Event is passed to a Handler when a mouse message is routed to its node.
+type Event struct {
+	Mouse  tea.MouseMsg
+	ID     string      // node whose handler is running
+	Target string      // deepest identified node under the cursor
+	Rect   render.Rect // where the node identified by ID landed
+	X, Y   int         // cursor position relative to Rect
+}
+
+// Handler responds to a routed mouse event.
+type Handler func(Event) tea.Cmd
+
+// Router dispatches mouse messages to handlers registered per node ID. Events
+// start at the deepest identified node under the cursor and bubble out through
+// its ancestors until one has a handler. The zero value is ready to use.
+type Router struct {
+	click map[string]Handler
+	wheel map[string]Handler
+}
+
+// OnClick registers handler for left-button presses on the node with id,
+// replacing any earlier registration.
+func (r *Router) OnClick(id string, handler Handler) {
+	if r.click == nil {
+		r.click = map[string]Handler{}
+	}
+	r.click[id] = handler
+}
+
+// OnWheel registers handler for scroll wheel events over the node with id,
+// replacing any earlier registration.
+func (r *Router) OnWheel(id string, handler Handler) {
+	if r.wheel == nil {
+		r.wheel = map[string]Handler{}
+	}
+	r.wheel[id] = handler
+}
+
+// Off removes every handler registered for the node with id. Models that drop
+// nodes at runtime call it so handlers for nodes that no longer exist do not
+// pile up.
+func (r *Router) Off(id string) {
+	delete(r.click, id)
+	delete(r.wheel, id)
+}
+
+// Route delivers msg to the nearest registered handler under the cursor and
+// returns its command. It reports false when the message was not a click or
+// wheel event, or when no handler claimed it.
+func (r *Router) Route(tree render.LayoutTree, msg tea.MouseMsg) (tea.Cmd, bool) {
+	var handlers map[string]Handler
+	switch {
+	case tea.MouseEvent(msg).IsWheel():
+		handlers = r.wheel
+	case msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft:
+		handlers = r.click
+	}
+	if len(handlers) == 0 {
+		return nil, false
+	}
+
+	path := identified(tree.At(msg.X, msg.Y))
+	if len(path) == 0 {
+		return nil, false
+	}
+
+	target := path[len(path)-1]
+	for i := len(path) - 1; i >= 0; i-- {
+		node := path[i]
+		handler, ok := handlers[bubbleviews.NodeID(node.Node)]
+		if !ok {
+			continue
+		}
+		return handler(Event{
+			Mouse:  msg,
+			ID:     bubbleviews.NodeID(node.Node),
+			Target: bubbleviews.NodeID(target.Node),
+			Rect:   node.Rect,
+			X:      msg.X - node.Rect.X,
+			Y:      msg.Y - node.Rect.Y,
+		}), true
+	}
+	return nil, false
+}
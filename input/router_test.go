@@ -0,0 +1,139 @@
+package input
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+// routerTree lays out a bordered card holding a button and an unnamed label:
+// the card spans rows 0-3, the button sits at 1,1 and the label on row 2.
+func routerTree() render.LayoutTree {
+	return render.Layout(bubbleviews.View{
+		Size: bubbleviews.Size{Width: 20, Height: 4},
+		Children: []bubbleviews.Node{bubbleviews.BoxNode{
+			ID:    "card",
+			Style: bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, FillWidth: true},
+			Content: bubbleviews.View{Children: []bubbleviews.Node{
+				bubbleviews.BoxNode{
+					ID:      "button",
+					Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: "Remove"}}},
+				},
+				bubbleviews.TextNode{Value: "label"},
+			}},
+		}},
+	})
+}
+
+func click(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}
+}
+
+// record registers handlers that store the event they receive.
+func record(events *[]Event) Handler {
+	return func(event Event) tea.Cmd {
+		*events = append(*events, event)
+		return nil
+	}
+}
+
+func TestRouterDispatchesByID(t *testing.T) {
+	tree := routerTree()
+	tests := []struct {
+		name   string
+		msg    tea.MouseMsg
+		id     string
+		target string
+		x, y   int
+	}{
+		{name: "deepest handler wins", msg: click(3, 1), id: "button", target: "button", x: 2, y: 0},
+		{name: "unnamed nodes bubble to their container", msg: click(2, 2), id: "card", target: "card", x: 2, y: 2},
+		{name: "border belongs to the card", msg: click(0, 0), id: "card", target: "card", x: 0, y: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []Event
+			var router Router
+			router.OnClick("card", record(&events))
+			router.OnClick("button", record(&events))
+
+			if _, ok := router.Route(tree, tt.msg); !ok {
+				t.Fatal("expected the click to be routed")
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected one handler to run, got %d", len(events))
+			}
+			got := events[0]
+			if got.ID != tt.id || got.Target != tt.target || got.X != tt.x || got.Y != tt.y {
+				t.Fatalf("expected %s/%s at %d,%d, got %s/%s at %d,%d", tt.id, tt.target, tt.x, tt.y, got.ID, got.Target, got.X, got.Y)
+			}
+		})
+	}
+}
+
+func TestRouterBubblesWheelEvents(t *testing.T) {
+	var clicks, wheels []Event
+	var router Router
+	router.OnClick("button", record(&clicks))
+	router.OnWheel("card", record(&wheels))
+
+	wheel := tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonWheelDown}
+	if _, ok := router.Route(routerTree(), wheel); !ok {
+		t.Fatal("expected the wheel event to be routed")
+	}
+	if len(clicks) != 0 || len(wheels) != 1 {
+		t.Fatalf("expected only the wheel handler to run, got %d clicks and %d wheels", len(clicks), len(wheels))
+	}
+	if got := wheels[0]; got.ID != "card" || got.Target != "button" || got.Rect.Height != 4 {
+		t.Fatalf("expected the card to handle a wheel over the button, got %+v", got)
+	}
+}
+
+func TestRouterMisses(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  tea.MouseMsg
+	}{
+		{name: "outside every node", msg: click(5, 10)},
+		{name: "release", msg: tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft}},
+		{name: "right button", msg: tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonRight}},
+		{name: "wheel without wheel handlers", msg: tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonWheelUp}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []Event
+			var router Router
+			router.OnClick("card", record(&events))
+
+			if _, ok := router.Route(routerTree(), tt.msg); ok || len(events) != 0 {
+				t.Fatalf("expected no handler to run, got %d events", len(events))
+			}
+		})
+	}
+}
+
+func TestRouterOff(t *testing.T) {
+	var events []Event
+	var router Router
+	router.OnClick("card", record(&events))
+	router.OnClick("button", record(&events))
+	router.OnWheel("button", record(&events))
+
+	router.Off("button")
+	router.Off("missing")
+	if _, ok := router.Route(routerTree(), click(3, 1)); !ok || len(events) != 1 || events[0].ID != "card" {
+		t.Fatalf("expected the click to fall through to the card, got %+v", events)
+	}
+
+	wheel := tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonWheelUp}
+	if _, ok := router.Route(routerTree(), wheel); ok {
+		t.Fatal("expected the wheel handler to be removed as well")
+	}
+
+	var empty Router
+	empty.Off("card")
+}
@@ -0,0 +1,117 @@
+package input
+
+import (
+	"math"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+// minRatio is the smallest ratio a resize produces. SplitNode reads a zero
+// ratio as unset and splits evenly, so a divider dragged to the edge stops
+// just short of it, which still rounds to an empty first pane.
+const minRatio = 1e-6
+
+// SplitResizer turns divider drags and key presses into new ratios for the
+// SplitNode with the given ID. Keep the ratio in the model, pass it to the
+// node, and run every message through Update. Splits sized with FirstSize
+// ignore the ratio and cannot be resized this way.
+type SplitResizer struct {
+	ID       string
+	Step     float64  // ratio change per key press; defaults to 0.05
+	Shrink   []string // keys that move the divider toward the first pane
+	Grow     []string // keys that move the divider toward the second pane
+	dragging bool
+}
+
+// Dragging reports whether a divider drag is in progress.
+func (r *SplitResizer) Dragging() bool {
+	return r.dragging
+}
+
+// Update applies msg to ratio using the layout the split was last painted
+// with. It returns the new ratio and reports whether msg changed it. The
+// result always respects the split's MinFirst and MinSecond and is never
+// zero.
+func (r *SplitResizer) Update(tree render.LayoutTree, ratio float64, msg tea.Msg) (float64, bool) {
+	laid, ok := tree.Find(r.ID)
+	if !ok {
+		return ratio, false
+	}
+	split, ok := derefSplit(laid.Node)
+	if !ok {
+		return ratio, false
+	}
+
+	row := split.Direction != bubbleviews.FlexDirectionColumn
+	total := laid.Rect.Width
+	if !row {
+		total = laid.Rect.Height
+	}
+	available := total - 1
+	if available <= 0 {
+		return ratio, false
+	}
+
+	next := ratio
+	if next <= 0 {
+		next = 0.5
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		step := r.Step
+		if step <= 0 {
+			step = 0.05
+		}
+		switch key := msg.String(); {
+		case slices.Contains(r.Shrink, key):
+			next -= step
+		case slices.Contains(r.Grow, key):
+			next += step
+		default:
+			return ratio, false
+		}
+	case tea.MouseMsg:
+		divider, _ := laid.Divider()
+		switch {
+		case msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft:
+			r.dragging = divider.Contains(msg.X, msg.Y)
+			return ratio, false
+		case msg.Action == tea.MouseActionRelease:
+			r.dragging = false
+			return ratio, false
+		case msg.Action != tea.MouseActionMotion || !r.dragging:
+			return ratio, false
+		}
+		position := msg.X - laid.Rect.X
+		if !row {
+			position = msg.Y - laid.Rect.Y
+		}
+		next = float64(position) / float64(available)
+	default:
+		return ratio, false
+	}
+
+	low := float64(max(split.MinFirst, 0)) / float64(available)
+	high := float64(available-max(split.MinSecond, 0)) / float64(available)
+	next = math.Max(math.Min(next, high), low)
+	next = math.Max(math.Min(next, 1), minRatio)
+	return next, next != ratio
+}
+
+func derefSplit(node bubbleviews.Node) (bubbleviews.SplitNode, bool) {
+	switch n := node.(type) {
+	case bubbleviews.SplitNode:
+		return n, true
+	case *bubbleviews.SplitNode:
+		if n == nil {
+			return bubbleviews.SplitNode{}, false
+		}
+		return *n, true
+	default:
+		return bubbleviews.SplitNode{}, false
+	}
+}
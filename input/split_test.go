@@ -0,0 +1,132 @@
+package input
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sprucelabsai-community/bubbleviews"
+	"github.com/sprucelabsai-community/bubbleviews/render"
+)
+
+// splitTree lays out a split 21 cells along its axis, leaving 20 for the
+// panes, so each cell of the divider's travel is a ratio step of 0.05.
+func splitTree(split bubbleviews.SplitNode) render.LayoutTree {
+	split.ID = "split"
+	split.First = bubbleviews.TextNode{Value: "a"}
+	split.Second = bubbleviews.TextNode{Value: "b"}
+	size := bubbleviews.Size{Width: 21, Height: 3}
+	if split.Direction == bubbleviews.FlexDirectionColumn {
+		size = bubbleviews.Size{Width: 3, Height: 21}
+	}
+	return render.Layout(bubbleviews.View{Size: size, Children: []bubbleviews.Node{split}})
+}
+
+func TestSplitResizerKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		split   bubbleviews.SplitNode
+		step    float64
+		ratio   float64
+		key     tea.KeyMsg
+		want    float64
+		changed bool
+	}{
+		{name: "grow", ratio: 0.5, key: tea.KeyMsg{Type: tea.KeyRight}, want: 0.55, changed: true},
+		{name: "shrink", ratio: 0.5, key: tea.KeyMsg{Type: tea.KeyLeft}, want: 0.45, changed: true},
+		{name: "custom step", step: 0.25, ratio: 0.5, key: tea.KeyMsg{Type: tea.KeyRight}, want: 0.75, changed: true},
+		{name: "unset ratio starts even", ratio: 0, key: tea.KeyMsg{Type: tea.KeyRight}, want: 0.55, changed: true},
+		{name: "shrink stops short of zero", ratio: 0.02, key: tea.KeyMsg{Type: tea.KeyLeft}, want: minRatio, changed: true},
+		{name: "grow stops at one", ratio: 0.98, key: tea.KeyMsg{Type: tea.KeyRight}, want: 1, changed: true},
+		{name: "MinFirst bounds shrinking", split: bubbleviews.SplitNode{MinFirst: 5}, ratio: 0.27, key: tea.KeyMsg{Type: tea.KeyLeft}, want: 0.25, changed: true},
+		{name: "MinSecond bounds growing", split: bubbleviews.SplitNode{MinSecond: 5}, ratio: 0.75, key: tea.KeyMsg{Type: tea.KeyRight}, want: 0.75},
+		{name: "other keys are ignored", ratio: 0.5, key: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}, want: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resizer := SplitResizer{ID: "split", Step: tt.step, Shrink: []string{"left"}, Grow: []string{"right"}}
+			split := tt.split
+			split.Ratio = tt.ratio
+			got, changed := resizer.Update(splitTree(split), tt.ratio, tt.key)
+			if fmt.Sprintf("%.6f", got) != fmt.Sprintf("%.6f", tt.want) || changed != tt.changed {
+				t.Fatalf("expected %v (%v), got %v (%v)", tt.want, tt.changed, got, changed)
+			}
+			if got <= 0 {
+				t.Fatalf("expected a positive ratio, got %v", got)
+			}
+		})
+	}
+}
+
+func press(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}
+}
+
+func motion(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft}
+}
+
+func TestSplitResizerDrag(t *testing.T) {
+	tests := []struct {
+		name  string
+		split bubbleviews.SplitNode
+		msgs  []tea.MouseMsg
+		want  float64
+	}{
+		{name: "drag moves the divider", msgs: []tea.MouseMsg{press(10, 1), motion(4, 1)}, want: 0.2},
+		{name: "drag to the edge stays positive", msgs: []tea.MouseMsg{press(10, 1), motion(0, 1)}, want: minRatio},
+		{name: "drag past MinSecond", split: bubbleviews.SplitNode{MinSecond: 4}, msgs: []tea.MouseMsg{press(10, 1), motion(20, 1)}, want: 0.8},
+		{name: "drag below MinFirst", split: bubbleviews.SplitNode{MinFirst: 3}, msgs: []tea.MouseMsg{press(10, 1), motion(1, 1)}, want: 0.15},
+		{
+			name:  "columns drag along y",
+			split: bubbleviews.SplitNode{Direction: bubbleviews.FlexDirectionColumn},
+			msgs:  []tea.MouseMsg{press(1, 10), motion(1, 15)},
+			want:  0.75,
+		},
+		{name: "press off the divider", msgs: []tea.MouseMsg{press(3, 1), motion(4, 1)}, want: 0.5},
+		{
+			name: "release ends the drag",
+			msgs: []tea.MouseMsg{press(10, 1), {X: 10, Y: 1, Action: tea.MouseActionRelease}, motion(4, 1)},
+			want: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resizer := SplitResizer{ID: "split"}
+			ratio := 0.5
+			for _, msg := range tt.msgs {
+				split := tt.split
+				split.Ratio = ratio
+				ratio, _ = resizer.Update(splitTree(split), ratio, msg)
+			}
+			if fmt.Sprintf("%.6f", ratio) != fmt.Sprintf("%.6f", tt.want) {
+				t.Fatalf("expected ratio %v, got %v", tt.want, ratio)
+			}
+		})
+	}
+}
+
+func TestSplitResizerDragToEdgeEmptiesFirstPane(t *testing.T) {
+	resizer := SplitResizer{ID: "split"}
+	tree := splitTree(bubbleviews.SplitNode{Ratio: 0.5})
+	resizer.Update(tree, 0.5, press(10, 1))
+	ratio, changed := resizer.Update(tree, 0.5, motion(0, 1))
+	if !changed || !resizer.Dragging() {
+		t.Fatalf("expected an active drag to change the ratio, got %v", ratio)
+	}
+
+	first, second := bubbleviews.SplitNode{Ratio: ratio}.PaneSizes(21)
+	if first != 0 || second != 20 {
+		t.Fatalf("expected panes 0/20, got %d/%d", first, second)
+	}
+}
+
+func TestSplitResizerIgnoresUnknownSplits(t *testing.T) {
+	resizer := SplitResizer{ID: "missing", Grow: []string{"right"}}
+	ratio, changed := resizer.Update(splitTree(bubbleviews.SplitNode{}), 0.5, tea.KeyMsg{Type: tea.KeyRight})
+	if changed || ratio != 0.5 {
+		t.Fatalf("expected no change, got %v (%v)", ratio, changed)
+	}
+}
@@ -0,0 +1,825 @@
+package bubbleviews
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MarkdownNode renders a CommonMark document: ATX and setext headings,
+// paragraphs, bullet and ordered lists (nested or not), block quotes, fenced
+// and indented code, thematic breaks and GitHub-style pipe tables. Inline
+// emphasis, strikethrough, code spans and links are styled; anything the
+// parser does not recognise is kept as plain text rather than rejected.
+//
+// The document is expanded into flex, box and text nodes at layout time, so it
+// wraps at whatever width its parent offers.
+type MarkdownNode struct {
+	ID     string
+	Source string
+	Style  MarkdownStyle
+}
+
+func (MarkdownNode) isNode() {}
+
+func (n MarkdownNode) nodeID() string { return n.ID }
+
+// MarkdownStyle colors the parts of a MarkdownNode. Zero fields fall back to
+// the defaults noted beside them.
+type MarkdownStyle struct {
+	Heading TextStyle // bold 63; level one headings are underlined as well
+	Code    TextStyle // 203 on 236, for code spans and code blocks
+	Link    TextStyle // underlined 69
+	Accent  Color     // 240, for list markers, quote bars, rules and table borders
+}
+
+func (s MarkdownStyle) withDefaults() MarkdownStyle {
+	if s.Heading == (TextStyle{}) {
+		s.Heading = TextStyle{Color: "63", Bold: true}
+	}
+	if s.Code == (TextStyle{}) {
+		s.Code = TextStyle{Color: "203", Background: "236"}
+	}
+	if s.Link == (TextStyle{}) {
+		s.Link = TextStyle{Color: "69", Underline: true}
+	}
+	if s.Accent == "" {
+		s.Accent = "240"
+	}
+	return s
+}
+
+// Node returns the document as a column of flex, box and text nodes, one
+// block per item with a blank line between blocks.
+func (n MarkdownNode) Node() Node {
+	p := markdownParser{style: n.Style.withDefaults()}
+	lines := strings.Split(strings.ReplaceAll(n.Source, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = expandMarkdownTabs(line)
+	}
+	return p.column(p.blocks(lines, 0), 1)
+}
+
+type markdownParser struct {
+	style MarkdownStyle
+}
+
+// blocks parses lines into block nodes. depth counts the lists enclosing
+// lines and picks their bullet glyphs.
+func (p markdownParser) blocks(lines []string, depth int) []Node {
+	var nodes []Node
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case isBlankLine(line):
+			i++
+		case isFenceOpen(line):
+			var node Node
+			node, i = p.fencedCode(lines, i)
+			nodes = append(nodes, node)
+		case markdownIndent(line) >= 4:
+			var node Node
+			node, i = p.indentedCode(lines, i)
+			nodes = append(nodes, node)
+		case isThematicBreak(line):
+			nodes = append(nodes, p.rule())
+			i++
+		case isQuoteLine(line):
+			var node Node
+			node, i = p.quote(lines, i, depth)
+			nodes = append(nodes, node)
+		default:
+			if level, text, ok := atxHeading(line); ok {
+				nodes = append(nodes, p.heading(level, text))
+				i++
+				continue
+			}
+			if _, ok := parseListMarker(line); ok {
+				var node Node
+				node, i = p.list(lines, i, depth)
+				nodes = append(nodes, node)
+				continue
+			}
+			if i+1 < len(lines) && strings.Contains(line, "|") {
+				if aligns, ok := tableDelimiter(lines[i+1]); ok && len(aligns) == len(splitTableRow(line)) {
+					var node Node
+					node, i = p.table(lines, i, aligns)
+					nodes = append(nodes, node)
+					continue
+				}
+			}
+			var node Node
+			node, i = p.paragraph(lines, i)
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// column stacks nodes vertically, returning a lone node as is.
+func (p markdownParser) column(nodes []Node, spacing int) Node {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+	items := make([]FlexItem, len(nodes))
+	for i, node := range nodes {
+		items[i] = FlexItem{Node: node}
+	}
+	return FlexNode{Direction: FlexDirectionColumn, Spacing: spacing, Items: items}
+}
+
+func (p markdownParser) heading(level int, text string) Node {
+	style := p.style.Heading
+	if level == 1 {
+		style.Underline = true
+	}
+	return RichTextNode{Spans: p.inline(text), Style: style, Wrap: true}
+}
+
+// paragraph collects lines until a blank line or the start of another block.
+// A paragraph followed by an = or - underline becomes a setext heading.
+func (p markdownParser) paragraph(lines []string, i int) (Node, int) {
+	var text []string
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if len(text) > 0 {
+			if level := setextLevel(line); level > 0 {
+				return p.heading(level, strings.Join(text, " ")), i + 1
+			}
+			if isBlankLine(line) || interruptsParagraph(line) {
+				break
+			}
+		}
+		text = append(text, line)
+	}
+
+	// Lines ending in two spaces or a backslash force a break; the rest
+	// reflow into one wrapped run.
+	var rows []Node
+	var current []string
+	for j, line := range text {
+		line = strings.TrimLeft(line, " ")
+		trimmed := strings.TrimRight(line, " ")
+		hard := j < len(text)-1 && (strings.HasSuffix(line, "  ") || strings.HasSuffix(trimmed, "\\"))
+		if hard {
+			trimmed = strings.TrimSuffix(trimmed, "\\")
+		}
+		current = append(current, trimmed)
+		if hard || j == len(text)-1 {
+			rows = append(rows, RichTextNode{Spans: p.inline(strings.Join(current, " ")), Wrap: true})
+			current = nil
+		}
+	}
+	return p.column(rows, 0), i
+}
+
+// codeBlock shades lines as a block. They keep their spacing and wrap by
+// character, not by word, when wider than the block.
+func (p markdownParser) codeBlock(lines []string) Node {
+	return BoxNode{
+		Style: BoxStyle{
+			Background: p.style.Code.Background,
+			Padding:    Padding{Left: 1, Right: 1},
+			FillWidth:  true,
+		},
+		Content: View{Children: []Node{
+			RichTextNode{
+				Spans:    []Span{{Text: strings.Join(lines, "\n")}},
+				Style:    p.style.Code,
+				Wrap:     true,
+				WrapMode: WrapCharacter,
+			},
+		}},
+	}
+}
+
+// fencedCode consumes a ``` or ~~~ block. An unclosed fence runs to the end
+// of the document.
+func (p markdownParser) fencedCode(lines []string, i int) (Node, int) {
+	indent := markdownIndent(lines[i])
+	open := strings.TrimLeft(lines[i], " ")
+	fence := open[:len(open)-len(strings.TrimLeft(open, open[:1]))]
+
+	var code []string
+	for i++; i < len(lines); i++ {
+		line := lines[i]
+		if markdownIndent(line) <= 3 {
+			closing := strings.TrimSpace(line)
+			if strings.HasPrefix(closing, fence) && strings.Trim(closing, fence[:1]) == "" {
+				i++
+				break
+			}
+		}
+		code = append(code, line[min(indent, markdownIndent(line)):])
+	}
+	return p.codeBlock(code), i
+}
+
+// indentedCode consumes lines indented by four or more spaces, along with any
+// blank lines between them.
+func (p markdownParser) indentedCode(lines []string, i int) (Node, int) {
+	var code []string
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if isBlankLine(line) {
+			code = append(code, "")
+			continue
+		}
+		if markdownIndent(line) < 4 {
+			break
+		}
+		code = append(code, line[4:])
+	}
+	for len(code) > 0 && code[len(code)-1] == "" {
+		code = code[:len(code)-1]
+	}
+	return p.codeBlock(code), i
+}
+
+func (p markdownParser) rule() Node {
+	return BoxNode{
+		Style: BoxStyle{
+			Border:      BorderThin,
+			BorderSides: BorderSides{Top: true},
+			BorderColor: p.style.Accent,
+			FillWidth:   true,
+		},
+	}
+}
+
+// quote consumes a block quote, including lazy continuation lines that drop
+// the > marker, and parses its content as a nested document.
+func (p markdownParser) quote(lines []string, i int, depth int) (Node, int) {
+	var inner []string
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if isQuoteLine(line) {
+			line = strings.TrimLeft(line, " ")[1:]
+			inner = append(inner, strings.TrimPrefix(line, " "))
+			continue
+		}
+		last := len(inner) - 1
+		if isBlankLine(line) || isBlankLine(inner[last]) || interruptsParagraph(line) {
+			break
+		}
+		inner = append(inner, line)
+	}
+
+	return BoxNode{
+		Style: BoxStyle{
+			Border:      BorderThick,
+			BorderSides: BorderSides{Left: true},
+			BorderColor: p.style.Accent,
+			Padding:     Padding{Left: 1},
+			FillWidth:   true,
+		},
+		Content: View{Children: []Node{p.column(p.blocks(inner, depth), 1)}},
+	}, i
+}
+
+// list consumes consecutive items sharing a marker type. Items separated by
+// blank lines, or holding several blocks apart, make the list loose and
+// spaced out.
+func (p markdownParser) list(lines []string, i int, depth int) (Node, int) {
+	first, _ := parseListMarker(lines[i])
+	var markers []listMarker
+	var contents [][]string
+	loose := false
+
+	for i < len(lines) {
+		marker, ok := parseListMarker(lines[i])
+		if !ok || !marker.continues(first) {
+			break
+		}
+		if len(contents) > 0 && isBlankLine(lines[i-1]) {
+			loose = true
+		}
+
+		item := []string{marker.content}
+		for i++; i < len(lines); i++ {
+			line := lines[i]
+			if isBlankLine(line) {
+				item = append(item, "")
+				continue
+			}
+			if markdownIndent(line) >= marker.contentIndent {
+				item = append(item, line[marker.contentIndent:])
+				continue
+			}
+			_, sibling := parseListMarker(line)
+			if last := item[len(item)-1]; last != "" && !sibling && !interruptsParagraph(line) {
+				item = append(item, strings.TrimLeft(line, " "))
+				continue
+			}
+			break
+		}
+
+		for len(item) > 1 && item[len(item)-1] == "" {
+			item = item[:len(item)-1]
+		}
+		for _, line := range item[1:] {
+			if line == "" {
+				loose = true
+			}
+		}
+		markers = append(markers, marker)
+		contents = append(contents, item)
+	}
+
+	// Ordered markers share the width of the widest number so item text
+	// lines up.
+	labels := make([]string, len(markers))
+	width := 0
+	for j, marker := range markers {
+		switch {
+		case marker.bullet != 0:
+			labels[j] = markdownBullets[depth%len(markdownBullets)]
+		default:
+			labels[j] = strconv.Itoa(first.start+j) + "."
+		}
+		width = max(width, utf8.RuneCountInString(labels[j])+1)
+	}
+
+	spacing := 0
+	if loose {
+		spacing = 1
+	}
+	items := make([]FlexItem, len(contents))
+	for j, content := range contents {
+		items[j] = FlexItem{Node: FlexNode{
+			Direction: FlexDirectionRow,
+			Items: []FlexItem{
+				{
+					Node:     TextNode{Value: labels[j], Style: TextStyle{Color: p.style.Accent}},
+					Basis:    Cells(width),
+					MinWidth: width,
+				},
+				{Node: p.column(p.blocks(content, depth+1), spacing), Grow: 1},
+			},
+		}}
+	}
+	return FlexNode{Direction: FlexDirectionColumn, Spacing: spacing, Items: items}, i
+}
+
+var markdownBullets = []string{"•", "◦", "▪"}
+
+// markdownNarrowColumn is the widest cell, in runes, a table column can hold
+// and still be sized to its content.
+const markdownNarrowColumn = 16
+
+// table consumes a pipe table whose header sits at lines[i] and whose
+// delimiter row gave aligns. Narrow columns keep their natural width and the
+// rest share what remains in proportion to their widest cell.
+func (p markdownParser) table(lines []string, i int, aligns []Alignment) (Node, int) {
+	rows := [][]string{splitTableRow(lines[i])}
+	for i += 2; i < len(lines); i++ {
+		if isBlankLine(lines[i]) || !strings.Contains(lines[i], "|") || interruptsParagraph(lines[i]) {
+			break
+		}
+		rows = append(rows, splitTableRow(lines[i]))
+	}
+
+	widths := make([]int, len(aligns))
+	var cells []GridCell
+	for row, values := range rows {
+		for column := range aligns {
+			value := ""
+			if column < len(values) {
+				value = values[column]
+			}
+			widths[column] = max(widths[column], utf8.RuneCountInString(value))
+
+			text := RichTextNode{Spans: p.inline(value), Wrap: true, Align: aligns[column]}
+			if row == 0 {
+				text.Style.Bold = true
+			}
+			cells = append(cells, GridCell{
+				Row:    row,
+				Column: column,
+				Node: BoxNode{
+					Style: BoxStyle{
+						Border:      BorderThin,
+						BorderColor: p.style.Accent,
+						Padding:     Padding{Left: 1, Right: 1},
+						FillWidth:   true,
+						FillHeight:  true,
+					},
+					Content: View{Children: []Node{text}},
+				},
+			})
+		}
+	}
+
+	columns := make([]Dimension, len(widths))
+	for column, width := range widths {
+		if width > markdownNarrowColumn {
+			columns[column] = Fraction(width)
+		}
+	}
+	return GridNode{Columns: columns, Cells: cells, CollapseBorders: true}, i
+}
+
+// inline styles emphasis, strikethrough, code spans and links in text.
+func (p markdownParser) inline(text string) []Span {
+	return p.inlineSpans(nil, text, TextStyle{})
+}
+
+func (p markdownParser) inlineSpans(spans []Span, src string, style TextStyle) []Span {
+	var text strings.Builder
+	flush := func() {
+		spans = appendSpan(spans, text.String(), style)
+		text.Reset()
+	}
+
+	for pos := 0; pos < len(src); {
+		rest := src[pos:]
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && strings.IndexByte(markdownPunctuation, rest[1]) >= 0:
+			text.WriteByte(rest[1])
+			pos += 2
+			continue
+		case rest[0] == '`':
+			run := len(rest) - len(strings.TrimLeft(rest, "`"))
+			if end := closingCodeRun(rest[run:], run); end >= 0 {
+				flush()
+				code := rest[run : run+end]
+				if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+					code = code[1 : len(code)-1]
+				}
+				spans = appendSpan(spans, code, p.style.Code.Inherit(style))
+				pos += run + end + run
+				continue
+			}
+			text.WriteString(rest[:run])
+			pos += run
+			continue
+		case rest[0] == '*' || rest[0] == '_' || strings.HasPrefix(rest, "~~"):
+			if delimiter, end, ok := emphasisSpan(src, pos); ok {
+				flush()
+				inner := style
+				switch delimiter {
+				case "**", "__":
+					inner.Bold = true
+				case "*", "_":
+					inner.Italic = true
+				case "~~":
+					inner.Strikethrough = true
+				}
+				spans = p.inlineSpans(spans, src[pos+len(delimiter):end], inner)
+				pos = end + len(delimiter)
+				continue
+			}
+			run := len(rest) - len(strings.TrimLeft(rest, rest[:1]))
+			text.WriteString(rest[:run])
+			pos += run
+			continue
+		case rest[0] == '[':
+			if label, url, size, ok := markdownLink(rest); ok {
+				flush()
+				spans = p.inlineSpans(spans, label, p.style.Link.Inherit(style))
+				if url != "" && url != label {
+					faint := style
+					faint.Faint = true
+					spans = appendSpan(spans, " ("+url+")", faint)
+				}
+				pos += size
+				continue
+			}
+		case rest[0] == '<':
+			if end := strings.IndexByte(rest, '>'); end > 0 && isAutolink(rest[1:end]) {
+				flush()
+				spans = appendSpan(spans, rest[1:end], p.style.Link.Inherit(style))
+				pos += end + 1
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(rest)
+		text.WriteRune(r)
+		pos += size
+	}
+	flush()
+	return spans
+}
+
+const markdownPunctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// closingCodeRun returns the offset in src of a backtick run exactly run
+// long, or -1 when the code span is never closed.
+func closingCodeRun(src string, run int) int {
+	for i := 0; i < len(src); {
+		if src[i] != '`' {
+			i++
+			continue
+		}
+		length := len(src[i:]) - len(strings.TrimLeft(src[i:], "`"))
+		if length == run {
+			return i
+		}
+		i += length
+	}
+	return -1
+}
+
+// emphasisSpan matches the delimiter opening at src[pos] with its closer and
+// returns the closer's offset. Openers must be followed, and closers
+// preceded, by something other than whitespace; underscores inside a word
+// are literal.
+func emphasisSpan(src string, pos int) (string, int, bool) {
+	rest := src[pos:]
+	delimiter := rest[:1]
+	if strings.HasPrefix(rest, delimiter+delimiter) {
+		delimiter += delimiter
+	}
+
+	after, _ := utf8.DecodeRuneInString(src[pos+len(delimiter):])
+	before, _ := utf8.DecodeLastRuneInString(src[:pos])
+	if after == utf8.RuneError || unicode.IsSpace(after) || (delimiter[0] == '_' && isWordRune(before)) {
+		return "", 0, false
+	}
+
+	for i := pos + len(delimiter); i < len(src); {
+		switch {
+		case src[i] == '\\':
+			i += 2
+			continue
+		case src[i] == '`':
+			run := len(src[i:]) - len(strings.TrimLeft(src[i:], "`"))
+			if end := closingCodeRun(src[i+run:], run); end >= 0 {
+				i += run + end + run
+				continue
+			}
+			i += run
+			continue
+		case src[i] != delimiter[0]:
+			i++
+			continue
+		}
+
+		run := len(src[i:]) - len(strings.TrimLeft(src[i:], delimiter[:1]))
+		prev, _ := utf8.DecodeLastRuneInString(src[:i])
+		next, _ := utf8.DecodeRuneInString(src[i+run:])
+		closes := i > pos+len(delimiter) && !unicode.IsSpace(prev) && (delimiter[0] != '_' || !isWordRune(next))
+		switch {
+		case closes && run == len(delimiter):
+			return delimiter, i, true
+		case closes && run > len(delimiter) && len(delimiter) == 2:
+			// ***x*** style runs close the outer delimiter last.
+			return delimiter, i + run - 2, true
+		}
+		i += run
+	}
+	return "", 0, false
+}
+
+// markdownLink matches [label](url) at the start of src and returns the
+// number of bytes it spans.
+func markdownLink(src string) (label, url string, size int, ok bool) {
+	depth := 0
+	for i := 0; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth > 0 {
+				continue
+			}
+			if i+1 >= len(src) || src[i+1] != '(' {
+				return "", "", 0, false
+			}
+			end := strings.IndexByte(src[i+2:], ')')
+			if end < 0 {
+				return "", "", 0, false
+			}
+			target := strings.TrimSpace(src[i+2 : i+2+end])
+			if space := strings.IndexAny(target, " \t"); space >= 0 {
+				target = target[:space] // drop a "title"
+			}
+			return src[1:i], strings.Trim(target, "<>"), i + 3 + end, true
+		}
+	}
+	return "", "", 0, false
+}
+
+// isAutolink reports whether text, found between < and >, is an absolute URI.
+func isAutolink(text string) bool {
+	scheme, rest, ok := strings.Cut(text, ":")
+	if !ok || len(scheme) < 2 || len(scheme) > 32 || rest == "" || strings.ContainsAny(text, " <") {
+		return false
+	}
+	for i, r := range scheme {
+		if !(r < utf8.RuneSelf && (unicode.IsLetter(r) || (i > 0 && (unicode.IsDigit(r) || strings.ContainsRune("+.-", r))))) {
+			return false
+		}
+	}
+	return true
+}
+
+// listMarker describes the marker opening a list item.
+type listMarker struct {
+	bullet        byte // -, + or *; zero for ordered items
+	delimiter     byte // . or ) after an ordered item's number
+	start         int
+	contentIndent int // column where the item's content begins
+	content       string
+}
+
+// continues reports whether an item with marker m belongs to the list that
+// first opened.
+func (m listMarker) continues(first listMarker) bool {
+	return m.bullet == first.bullet && m.delimiter == first.delimiter
+}
+
+func parseListMarker(line string) (listMarker, bool) {
+	indent := markdownIndent(line)
+	if indent > 3 {
+		return listMarker{}, false
+	}
+	rest := line[indent:]
+
+	var marker listMarker
+	width := 0
+	switch {
+	case rest != "" && strings.IndexByte("-+*", rest[0]) >= 0:
+		marker.bullet = rest[0]
+		width = 1
+	default:
+		digits := len(rest) - len(strings.TrimLeft(rest, "0123456789"))
+		if digits == 0 || digits > 9 || digits >= len(rest) || (rest[digits] != '.' && rest[digits] != ')') {
+			return listMarker{}, false
+		}
+		marker.start, _ = strconv.Atoi(rest[:digits])
+		marker.delimiter = rest[digits]
+		width = digits + 1
+	}
+
+	after := rest[width:]
+	content := strings.TrimLeft(after, " ")
+	spaces := len(after) - len(content)
+	if after != "" && spaces == 0 {
+		return listMarker{}, false
+	}
+	if content == "" || spaces > 4 {
+		spaces = 1
+	}
+	marker.contentIndent = indent + width + spaces
+	marker.content = line[min(marker.contentIndent, len(line)):]
+	return marker, true
+}
+
+// interruptsParagraph reports whether line starts a block that ends an open
+// paragraph. Empty list items and ordered lists not starting at 1 do not.
+func interruptsParagraph(line string) bool {
+	if isFenceOpen(line) || isThematicBreak(line) || isQuoteLine(line) {
+		return true
+	}
+	if _, _, ok := atxHeading(line); ok {
+		return true
+	}
+	marker, ok := parseListMarker(line)
+	return ok && strings.TrimSpace(marker.content) != "" && (marker.bullet != 0 || marker.start == 1)
+}
+
+func atxHeading(line string) (int, string, bool) {
+	if markdownIndent(line) > 3 {
+		return 0, "", false
+	}
+	rest := strings.TrimLeft(line, " ")
+	level := len(rest) - len(strings.TrimLeft(rest, "#"))
+	if level == 0 || level > 6 || (len(rest) > level && rest[level] != ' ') {
+		return 0, "", false
+	}
+
+	text := strings.TrimSpace(rest[level:])
+	if closing := strings.TrimRight(text, "#"); closing == "" || strings.HasSuffix(closing, " ") {
+		text = strings.TrimSpace(closing)
+	}
+	return level, text, true
+}
+
+// setextLevel returns 1 or 2 when line underlines a paragraph with = or -,
+// and 0 otherwise.
+func setextLevel(line string) int {
+	if markdownIndent(line) > 3 {
+		return 0
+	}
+	underline := strings.TrimSpace(line)
+	switch {
+	case underline == "":
+		return 0
+	case strings.Trim(underline, "=") == "":
+		return 1
+	case strings.Trim(underline, "-") == "":
+		return 2
+	}
+	return 0
+}
+
+func isThematicBreak(line string) bool {
+	if markdownIndent(line) > 3 {
+		return false
+	}
+	compact := strings.ReplaceAll(strings.TrimSpace(line), " ", "")
+	return len(compact) >= 3 && strings.IndexByte("-*_", compact[0]) >= 0 && strings.Trim(compact, compact[:1]) == ""
+}
+
+func isFenceOpen(line string) bool {
+	if markdownIndent(line) > 3 {
+		return false
+	}
+	rest := strings.TrimLeft(line, " ")
+	if !strings.HasPrefix(rest, "```") && !strings.HasPrefix(rest, "~~~") {
+		return false
+	}
+	// A backtick fence's info string cannot itself hold backticks.
+	return rest[0] == '~' || !strings.Contains(strings.TrimLeft(rest, "`"), "`")
+}
+
+func isQuoteLine(line string) bool {
+	return markdownIndent(line) <= 3 && strings.HasPrefix(strings.TrimLeft(line, " "), ">")
+}
+
+// tableDelimiter parses the row under a table header, such as | :-- | --: |,
+// into one alignment per column.
+func tableDelimiter(line string) ([]Alignment, bool) {
+	if !strings.Contains(line, "-") {
+		return nil, false
+	}
+	cells := splitTableRow(line)
+	aligns := make([]Alignment, len(cells))
+	for i, cell := range cells {
+		dashes := strings.Trim(cell, ":")
+		if dashes == "" || strings.Trim(dashes, "-") != "" {
+			return nil, false
+		}
+		left, right := strings.HasPrefix(cell, ":"), strings.HasSuffix(cell, ":")
+		switch {
+		case left && right:
+			aligns[i] = AlignCenter
+		case right:
+			aligns[i] = AlignEnd
+		default:
+			aligns[i] = AlignStart
+		}
+	}
+	return aligns, true
+}
+
+// splitTableRow splits a pipe table row into trimmed cells, dropping the
+// optional outer pipes. Escaped pipes stay in the cell.
+func splitTableRow(line string) []string {
+	row := strings.TrimSpace(line)
+	row = strings.TrimPrefix(row, "|")
+	if strings.HasSuffix(row, "|") && !strings.HasSuffix(row, "\\|") {
+		row = row[:len(row)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(row); i++ {
+		switch {
+		case row[i] == '\\' && i+1 < len(row) && row[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case row[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(row[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func isBlankLine(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func markdownIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// expandMarkdownTabs replaces tabs with spaces up to the next multiple of four
+// columns, so indentation can be measured in spaces alone.
+func expandMarkdownTabs(line string) string {
+	if !strings.Contains(line, "\t") {
+		return line
+	}
+	var builder strings.Builder
+	column := 0
+	for _, r := range line {
+		if r == '\t' {
+			spaces := 4 - column%4
+			builder.WriteString(strings.Repeat(" ", spaces))
+			column += spaces
+			continue
+		}
+		builder.WriteRune(r)
+		column++
+	}
+	return builder.String()
+}
@@ -0,0 +1,260 @@
+package bubbleviews
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MarkupError reports malformed markup passed to ParseMarkup.
+type MarkupError struct {
+	Offset int // byte offset into the markup where the problem starts
+	Msg    string
+}
+
+func (e *MarkupError) Error() string {
+	return fmt.Sprintf("markup: %s at offset %d", e.Msg, e.Offset)
+}
+
+// ParseMarkup turns lightweight markup into a RichTextNode, leaving layout
+// fields such as Wrap and Align for the caller to set:
+//
+//	**bold**  _italic_  `code`  [text](color:205)  [text](color:231,bg:63)
+//
+// Bold, italic and bracketed text nest. Code spans are drawn reversed and
+// keep their content literally. Parentheses are plain text except straight
+// after bracketed text, and an underscore between two letters or digits, as
+// in snake_case, is plain text too. A backslash escapes any of \ * _ ` [ ] ( )
+// and is kept as written before anything else; see EscapeMarkup.
+// Unclosed, misnested or unknown markup fails with a *MarkupError.
+func ParseMarkup(markup string) (RichTextNode, error) {
+	p := markupParser{src: markup}
+	if err := p.parse(TextStyle{}, ""); err != nil {
+		return RichTextNode{}, err
+	}
+	return RichTextNode{Spans: p.spans}, nil
+}
+
+// EscapeMarkup escapes text so ParseMarkup reproduces it literally, for
+// interpolating values such as names into markup.
+func EscapeMarkup(text string) string {
+	var builder strings.Builder
+	for _, r := range text {
+		if strings.ContainsRune(markupSpecials, r) {
+			builder.WriteByte('\\')
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+const markupSpecials = "\\*_`[]()"
+
+type markupParser struct {
+	src     string
+	pos     int
+	spans   []Span
+	closers []string // delimiters that would close the enclosing spans, innermost last
+}
+
+// parse consumes markup in style until it meets closer, or the end of the
+// source when closer is empty.
+func (p *markupParser) parse(style TextStyle, closer string) error {
+	start := p.pos
+	var text strings.Builder
+	flush := func() {
+		p.add(text.String(), style)
+		text.Reset()
+	}
+
+	for p.pos < len(p.src) {
+		rest := p.src[p.pos:]
+		if closer != "" && strings.HasPrefix(rest, closer) && (closer != "_" || p.underscoreDelimits()) {
+			flush()
+			p.pos += len(closer)
+			return nil
+		}
+
+		switch {
+		case rest[0] == '\\':
+			r, size := utf8.DecodeRuneInString(rest[1:])
+			if size > 0 && strings.ContainsRune(markupSpecials, r) {
+				text.WriteRune(r)
+				p.pos += 1 + size
+				continue
+			}
+			text.WriteByte('\\')
+			p.pos++
+		case strings.HasPrefix(rest, "**"):
+			flush()
+			if err := p.nest("**", func(s TextStyle) TextStyle { s.Bold = true; return s }, style); err != nil {
+				return err
+			}
+		case rest[0] == '_' && p.underscoreDelimits():
+			flush()
+			if err := p.nest("_", func(s TextStyle) TextStyle { s.Italic = true; return s }, style); err != nil {
+				return err
+			}
+		case rest[0] == '`':
+			flush()
+			if err := p.code(style); err != nil {
+				return err
+			}
+		case rest[0] == '[':
+			flush()
+			if err := p.bracket(style); err != nil {
+				return err
+			}
+		case rest[0] == ']':
+			return &MarkupError{Offset: p.pos, Msg: "unexpected ]"}
+		default:
+			r, size := utf8.DecodeRuneInString(rest)
+			text.WriteRune(r)
+			p.pos += size
+		}
+	}
+
+	if closer != "" {
+		return &MarkupError{Offset: start - len(closer), Msg: "unclosed " + closer}
+	}
+	flush()
+	return nil
+}
+
+// nest parses the span opened by delimiter at the current position.
+func (p *markupParser) nest(delimiter string, apply func(TextStyle) TextStyle, style TextStyle) error {
+	for _, open := range p.closers {
+		if open == delimiter {
+			return &MarkupError{Offset: p.pos, Msg: fmt.Sprintf("%s closes a span that is still waiting for %s", delimiter, p.closers[len(p.closers)-1])}
+		}
+	}
+
+	p.pos += len(delimiter)
+	p.closers = append(p.closers, delimiter)
+	err := p.parse(apply(style), delimiter)
+	p.closers = p.closers[:len(p.closers)-1]
+	return err
+}
+
+// code consumes a code span, whose content is taken literally.
+func (p *markupParser) code(style TextStyle) error {
+	end := strings.IndexByte(p.src[p.pos+1:], '`')
+	if end < 0 {
+		return &MarkupError{Offset: p.pos, Msg: "unclosed `"}
+	}
+	style.Reverse = true
+	p.add(p.src[p.pos+1:p.pos+1+end], style)
+	p.pos += end + 2
+	return nil
+}
+
+// bracket consumes [text](directives). The directives are read first so the
+// bracketed text can be parsed in its final style.
+func (p *markupParser) bracket(style TextStyle) error {
+	open := p.pos
+	closeAt, err := p.matchBracket(open)
+	if err != nil {
+		return err
+	}
+
+	after := closeAt + 1
+	if after >= len(p.src) || p.src[after] != '(' {
+		return &MarkupError{Offset: open, Msg: "[text] must be followed by (directives)"}
+	}
+	end := strings.IndexByte(p.src[after:], ')')
+	if end < 0 {
+		return &MarkupError{Offset: after, Msg: "unclosed ("}
+	}
+	styled, err := applyDirectives(style, p.src[after+1:after+end], after+1)
+	if err != nil {
+		return err
+	}
+
+	p.pos = open + 1
+	p.closers = append(p.closers, "]")
+	err = p.parse(styled, "]")
+	p.closers = p.closers[:len(p.closers)-1]
+	if err != nil {
+		return err
+	}
+	p.pos = after + end + 1
+	return nil
+}
+
+// matchBracket finds the ] closing the [ at open, skipping escapes, code
+// spans and nested brackets.
+func (p *markupParser) matchBracket(open int) (int, error) {
+	depth := 0
+	for i := open; i < len(p.src); i++ {
+		switch p.src[i] {
+		case '\\':
+			i++
+		case '`':
+			end := strings.IndexByte(p.src[i+1:], '`')
+			if end < 0 {
+				return 0, &MarkupError{Offset: i, Msg: "unclosed `"}
+			}
+			i += end + 1
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, &MarkupError{Offset: open, Msg: "unclosed ["}
+}
+
+// applyDirectives layers comma-separated key:value pairs over style.
+func applyDirectives(style TextStyle, directives string, offset int) (TextStyle, error) {
+	for _, directive := range strings.Split(directives, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(directive), ":")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || value == "" {
+			return style, &MarkupError{Offset: offset, Msg: fmt.Sprintf("directive %q needs a key:value pair", directive)}
+		}
+		switch key {
+		case "color":
+			style.Color = Color(value)
+		case "bg":
+			style.Background = Color(value)
+		default:
+			return style, &MarkupError{Offset: offset, Msg: fmt.Sprintf("unknown directive %q", key)}
+		}
+		offset += len(directive) + 1
+	}
+	return style, nil
+}
+
+// underscoreDelimits reports whether the underscore at the current position
+// is markup rather than part of a word like snake_case.
+func (p *markupParser) underscoreDelimits() bool {
+	before, _ := utf8.DecodeLastRuneInString(p.src[:p.pos])
+	after, _ := utf8.DecodeRuneInString(p.src[p.pos+1:])
+	return !(isWordRune(before) && isWordRune(after))
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// add appends text in style to the parsed spans.
+func (p *markupParser) add(text string, style TextStyle) {
+	p.spans = appendSpan(p.spans, text, style)
+}
+
+// appendSpan appends text in style, merging it into the last span when the
+// two match.
+func appendSpan(spans []Span, text string, style TextStyle) []Span {
+	if text == "" {
+		return spans
+	}
+	if last := len(spans) - 1; last >= 0 && spans[last].Style == style {
+		spans[last].Text += text
+		return spans
+	}
+	return append(spans, Span{Text: text, Style: style})
+}
@@ -0,0 +1,82 @@
+package bubbleviews
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseMarkup(t *testing.T) {
+	bold := TextStyle{Bold: true}
+	tests := []struct {
+		name   string
+		markup string
+		want   []Span
+	}{
+		{"plain", "Receiving stream", []Span{{Text: "Receiving stream"}}},
+		{"bold", "FPS: **30**", []Span{{Text: "FPS: "}, {Text: "30", Style: bold}}},
+		{"nested", "**a _b_**", []Span{{Text: "a ", Style: bold}, {Text: "b", Style: TextStyle{Bold: true, Italic: true}}}},
+		{"code is literal", "run `go **test**`", []Span{{Text: "run "}, {Text: "go **test**", Style: TextStyle{Reverse: true}}}},
+		{"directives", "[Camera **4**](color:205, bg:236) down", []Span{
+			{Text: "Camera ", Style: TextStyle{Color: "205", Background: "236"}},
+			{Text: "4", Style: TextStyle{Color: "205", Background: "236", Bold: true}},
+			{Text: " down"},
+		}},
+		{"snake case", "dock_camera_4 _live_", []Span{{Text: "dock_camera_4 "}, {Text: "live", Style: TextStyle{Italic: true}}}},
+		{"escapes", `\*\*not bold\*\* \[x\] C:\path`, []Span{{Text: `**not bold** [x] C:\path`}}},
+		{"bare parens", "uptime (3d)", []Span{{Text: "uptime (3d)"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := ParseMarkup(tt.markup)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(node.Spans, tt.want) {
+				t.Fatalf("expected %+v, got %+v", tt.want, node.Spans)
+			}
+		})
+	}
+}
+
+func TestParseMarkupErrors(t *testing.T) {
+	tests := []struct {
+		markup string
+		offset int
+	}{
+		{"FPS: **30", 5},
+		{"_open", 0},
+		{"`code", 0},
+		{"[text]", 0},
+		{"[text](color:205", 6},
+		{"[text](size:2)", 7},
+		{"[text](color)", 7},
+		{"**a _b** c_", 6},
+		{"stray ]", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.markup, func(t *testing.T) {
+			_, err := ParseMarkup(tt.markup)
+			var markupErr *MarkupError
+			if !errors.As(err, &markupErr) {
+				t.Fatalf("expected a *MarkupError, got %v", err)
+			}
+			if markupErr.Offset != tt.offset {
+				t.Fatalf("expected offset %d, got %d (%v)", tt.offset, markupErr.Offset, err)
+			}
+		})
+	}
+}
+
+func TestEscapeMarkupRoundTrips(t *testing.T) {
+	raw := `dock_[4] **live** \ (north) ` + "`cam`"
+	node, err := ParseMarkup("Started " + EscapeMarkup(raw))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []Span{{Text: "Started " + raw}}; !reflect.DeepEqual(node.Spans, want) {
+		t.Fatalf("expected %+v, got %+v", want, node.Spans)
+	}
+}
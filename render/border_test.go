@@ -0,0 +1,189 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func boxView(width int, style bubbleviews.BoxStyle, children ...bubbleviews.Node) bubbleviews.View {
+	return bubbleviews.View{
+		Size: bubbleviews.Size{Width: width},
+		Children: []bubbleviews.Node{bubbleviews.BoxNode{
+			Style:   style,
+			Content: bubbleviews.View{Children: children},
+		}},
+	}
+}
+
+func TestBorderLabelsTruncate(t *testing.T) {
+	tests := []struct {
+		width int
+		want  []string
+	}{
+		{width: 18, want: []string{"┌─ Loading Dock ─┐", "└───────── live ─┘"}},
+		{width: 16, want: []string{"┌─ Loading D… ─┐", "└─────── live ─┘"}},
+		{width: 14, want: []string{"┌─ Loading… ─┐", "└───── live ─┘"}},
+		{width: 10, want: []string{"┌─ Loa… ─┐", "└─ live ─┘"}},
+		{width: 7, want: []string{"┌ Lo… ┐", "└ li… ┘"}},
+		{width: 6, want: []string{"┌ L… ┐", "└ l… ┘"}},
+		{width: 5, want: []string{"┌Lo…┐", "└li…┘"}},
+		{width: 4, want: []string{"┌L…┐", "└l…┘"}},
+		{width: 3, want: []string{"┌L┐", "└l┘"}},
+		{width: 2, want: []string{"┌┐", "└┘"}},
+	}
+
+	for _, tt := range tests {
+		style := bubbleviews.BoxStyle{
+			Border:      bubbleviews.BorderThin,
+			FillWidth:   true,
+			Title:       "Loading Dock",
+			Footer:      "live",
+			FooterAlign: bubbleviews.AlignEnd,
+		}
+		got := Render(boxView(tt.width, style))
+		if want := strings.Join(tt.want, "\n"); got != want {
+			t.Fatalf("width %d: expected\n%s\ngot\n%s", tt.width, want, got)
+		}
+	}
+}
+
+func TestBorderLabelsAlign(t *testing.T) {
+	tests := []struct {
+		align bubbleviews.Alignment
+		want  string
+	}{
+		{align: bubbleviews.AlignStart, want: "┌─ cam ──────┐"},
+		{align: bubbleviews.AlignCenter, want: "┌─── cam ────┐"},
+		{align: bubbleviews.AlignEnd, want: "┌────── cam ─┐"},
+	}
+
+	for _, tt := range tests {
+		style := bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, FillWidth: true, Title: "cam", TitleAlign: tt.align}
+		got := strings.Split(Render(boxView(14, style)), "\n")[0]
+		if got != tt.want {
+			t.Fatalf("%s: expected %q, got %q", tt.align, tt.want, got)
+		}
+	}
+}
+
+func TestBorderStyles(t *testing.T) {
+	tests := []struct {
+		border bubbleviews.BorderStyle
+		want   []string
+	}{
+		{border: "", want: []string{"ab"}},
+		{border: bubbleviews.BorderNone, want: []string{"ab"}},
+		{border: bubbleviews.BorderThin, want: []string{"┌──┐", "│ab│", "└──┘"}},
+		{border: bubbleviews.BorderThick, want: []string{"┏━━┓", "┃ab┃", "┗━━┛"}},
+		{border: bubbleviews.BorderRounded, want: []string{"╭──╮", "│ab│", "╰──╯"}},
+		{border: bubbleviews.BorderDouble, want: []string{"╔══╗", "║ab║", "╚══╝"}},
+		{border: bubbleviews.BorderHidden, want: []string{"    ", " ab ", "    "}},
+		{border: bubbleviews.BorderBlock, want: []string{"████", "█ab█", "████"}},
+		{border: bubbleviews.BorderOuterHalfBlock, want: []string{"▛▀▀▜", "▌ab▐", "▙▄▄▟"}},
+		{border: bubbleviews.BorderASCII, want: []string{"+--+", "|ab|", "+--+"}},
+		{border: bubbleviews.BorderCustom, want: []string{`/~~\`, "[ab]", `\==/`}},
+	}
+
+	custom := bubbleviews.BorderRunes{
+		Top: "~", Bottom: "=", Left: "[", Right: "]",
+		TopLeft: "/", TopRight: `\`, BottomLeft: `\`, BottomRight: "/",
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.border), func(t *testing.T) {
+			style := bubbleviews.BoxStyle{Border: tt.border, CustomBorder: custom}
+			got := Render(boxView(0, style, bubbleviews.TextNode{Value: "ab"}))
+			if want := strings.Join(tt.want, "\n"); got != want {
+				t.Fatalf("expected\n%s\ngot\n%s", want, got)
+			}
+		})
+	}
+}
+
+func TestCustomBorderLeavesEmptyRunesBlank(t *testing.T) {
+	style := bubbleviews.BoxStyle{
+		Border:       bubbleviews.BorderCustom,
+		CustomBorder: bubbleviews.BorderRunes{Left: "▎"},
+	}
+	got := Render(boxView(0, style, bubbleviews.TextNode{Value: "ab"}))
+	if want := strings.Join([]string{"    ", "▎ab ", "    "}, "\n"); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestBorderSides(t *testing.T) {
+	tests := []struct {
+		name    string
+		sides   bubbleviews.BorderSides
+		want    []string
+		content Rect
+	}{
+		{name: "left only", sides: bubbleviews.BorderSides{Left: true}, want: []string{"│ ab  ", "│ cd  "}, content: Rect{X: 2, Y: 0, Width: 4, Height: 2}},
+		{name: "bottom only", sides: bubbleviews.BorderSides{Bottom: true}, want: []string{" ab   ", " cd   ", "──────"}, content: Rect{X: 1, Y: 0, Width: 5, Height: 2}},
+		{name: "top and left", sides: bubbleviews.BorderSides{Top: true, Left: true}, want: []string{"┌─────", "│ ab  ", "│ cd  "}, content: Rect{X: 2, Y: 1, Width: 4, Height: 2}},
+		{name: "left and right", sides: bubbleviews.BorderSides{Left: true, Right: true}, want: []string{"│ ab │", "│ cd │"}, content: Rect{X: 2, Y: 0, Width: 3, Height: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := boxView(6, bubbleviews.BoxStyle{
+				Border:      bubbleviews.BorderThin,
+				BorderSides: tt.sides,
+				Padding:     bubbleviews.Padding{Left: 1},
+				FillWidth:   true,
+			}, bubbleviews.TextNode{Value: "ab\ncd"})
+
+			laid := Layout(view)
+			if got := Paint(laid); got != strings.Join(tt.want, "\n") {
+				t.Fatalf("expected\n%s\ngot\n%s", strings.Join(tt.want, "\n"), got)
+			}
+			if got := laid.Nodes[0].Content; got != tt.content {
+				t.Fatalf("expected content %v, got %v", tt.content, got)
+			}
+		})
+	}
+}
+
+// paintCells paints view onto a canvas so tests can inspect cell styles that
+// the plain string output drops.
+func paintCells(view bubbleviews.View) *canvas {
+	tree := Layout(view)
+	surface := newCanvas(tree.Size.Width, tree.Size.Height)
+	for i := range tree.Nodes {
+		paintNode(surface, &tree.Nodes[i], surface.bounds())
+	}
+	return surface
+}
+
+// styleMap draws one rune per cell, picked by key from the cell's style.
+func styleMap(surface *canvas, key func(cellStyle) string) string {
+	rows := make([]string, len(surface.cells))
+	for y, row := range surface.cells {
+		for _, c := range row {
+			rows[y] += key(c.style)
+		}
+	}
+	return strings.Join(rows, "\n")
+}
+
+func TestBorderColorsPerEdge(t *testing.T) {
+	surface := paintCells(boxView(5, bubbleviews.BoxStyle{
+		Border:       bubbleviews.BorderThin,
+		BorderColor:  "1",
+		BorderColors: bubbleviews.BorderColors{Left: "2", Bottom: "3"},
+		FillWidth:    true,
+	}, bubbleviews.TextNode{Value: "ab"}))
+
+	got := styleMap(surface, func(style cellStyle) string {
+		if style.fg == "" {
+			return "."
+		}
+		return style.fg
+	})
+	// Corners take the color of the top or bottom edge they close.
+	want := strings.Join([]string{"11111", "2...1", "33333"}, "\n")
+	if got != want {
+		t.Fatalf("expected colors\n%s\ngot\n%s", want, got)
+	}
+}
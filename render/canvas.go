@@ -0,0 +1,215 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// cellStyle is the styling painted into a single cell. It stays comparable so
+// runs of equally styled cells can be emitted together.
+type cellStyle struct {
+	fg            string
+	bg            string // left empty, the cell keeps the background beneath it
+	bold          bool
+	italic        bool
+	underline     bool
+	strikethrough bool
+	faint         bool
+	reverse       bool
+	blink         bool
+}
+
+// textCellStyle converts the public text style into cell styling.
+func textCellStyle(style bubbleviews.TextStyle) cellStyle {
+	return cellStyle{
+		fg:            string(style.Color),
+		bg:            string(style.Background),
+		bold:          style.Bold,
+		italic:        style.Italic,
+		underline:     style.Underline,
+		strikethrough: style.Strikethrough,
+		faint:         style.Faint,
+		reverse:       style.Reverse,
+		blink:         style.Blink,
+	}
+}
+
+func (s cellStyle) lipgloss() lipgloss.Style {
+	style := lipgloss.NewStyle()
+	if s.fg != "" {
+		style = style.Foreground(lipgloss.Color(s.fg))
+	}
+	if s.bg != "" {
+		style = style.Background(lipgloss.Color(s.bg))
+	}
+	return style.
+		Bold(s.bold).
+		Italic(s.italic).
+		Underline(s.underline).
+		Strikethrough(s.strikethrough).
+		Faint(s.faint).
+		Reverse(s.reverse).
+		Blink(s.blink)
+}
+
+// cell holds one grapheme. Wide graphemes occupy their first cell and leave
+// the following ones empty so the row keeps its width.
+type cell struct {
+	content string
+	style   cellStyle
+	wide    bool // continuation of the wide grapheme to the left
+}
+
+// canvas is a grid of cells that painting writes into. Each cell is replaced
+// wholesale, so later writes cover earlier ones cleanly.
+type canvas struct {
+	width, height int
+	cells         [][]cell
+}
+
+func newCanvas(width, height int) *canvas {
+	width, height = max(width, 0), max(height, 0)
+	cells := make([][]cell, height)
+	for y := range cells {
+		cells[y] = make([]cell, width)
+		for x := range cells[y] {
+			cells[y][x] = cell{content: " "}
+		}
+	}
+	return &canvas{width: width, height: height, cells: cells}
+}
+
+func (c *canvas) bounds() Rect {
+	return Rect{Width: c.width, Height: c.height}
+}
+
+// fill replaces every cell in rect with a blank in style, hiding whatever
+// was painted there before, background included.
+func (c *canvas) fill(rect Rect, style cellStyle) {
+	rect = rect.intersect(c.bounds())
+	for y := rect.Y; y < rect.Y+rect.Height; y++ {
+		for x := rect.X; x < rect.X+rect.Width; x++ {
+			c.cells[y][x] = cell{content: " ", style: style}
+		}
+	}
+}
+
+// clear blanks every cell in rect but keeps its background.
+func (c *canvas) clear(rect Rect) {
+	rect = rect.intersect(c.bounds())
+	for y := rect.Y; y < rect.Y+rect.Height; y++ {
+		for x := rect.X; x < rect.X+rect.Width; x++ {
+			c.cells[y][x] = cell{content: " ", style: cellStyle{bg: c.cells[y][x].style.bg}}
+		}
+	}
+}
+
+// writeString draws text starting at x, y, skipping any cell outside clip.
+// Escape sequences already present in text stay attached to the grapheme
+// that follows them. It returns the number of cells advanced.
+func (c *canvas) writeString(x, y int, text string, style cellStyle, clip Rect) int {
+	clip = clip.intersect(c.bounds())
+	start := x
+	pending := ""
+
+	for text != "" {
+		seq, width, n, _ := ansi.DecodeSequence(text, ansi.NormalState, nil)
+		text = text[n:]
+		if width == 0 {
+			if strings.HasPrefix(seq, "\x1b") {
+				pending += seq
+			}
+			continue
+		}
+
+		c.set(x, y, cell{content: pending + seq, style: style}, clip)
+		for i := 1; i < width; i++ {
+			c.set(x+i, y, cell{style: style, wide: true}, clip)
+		}
+		pending = ""
+		x += width
+	}
+
+	return x - start
+}
+
+// join draws a single line glyph at x, y, merging it with a line already in
+// the cell so borders drawn over one another meet in a junction.
+func (c *canvas) join(x, y int, glyph string, style cellStyle, clip Rect) int {
+	if c.bounds().Contains(x, y) {
+		glyph = joinGlyphs(c.cells[y][x].content, glyph)
+	}
+	return c.writeString(x, y, glyph, style, clip)
+}
+
+func (c *canvas) set(x, y int, value cell, clip Rect) {
+	if !clip.Contains(x, y) {
+		return
+	}
+	row := c.cells[y]
+	if value.style.bg == "" {
+		value.style.bg = row[x].style.bg
+	}
+	// Overwriting half of a wide grapheme blanks the other half.
+	if row[x].wide && x > 0 && !value.wide {
+		for left := x - 1; left >= 0; left-- {
+			wasWide := row[left].wide
+			row[left] = cell{content: " ", style: row[left].style}
+			if !wasWide {
+				break
+			}
+		}
+	}
+	for right := x + 1; right < len(row) && row[right].wide && !value.wide; right++ {
+		row[right] = cell{content: " ", style: row[right].style}
+	}
+	row[x] = value
+}
+
+// String emits the canvas row by row, styling runs of cells that share a
+// style together.
+func (c *canvas) String() string {
+	styles := map[cellStyle]lipgloss.Style{}
+	var out strings.Builder
+
+	for y, row := range c.cells {
+		if y > 0 {
+			out.WriteByte('\n')
+		}
+
+		raw := false
+		for x := 0; x < len(row); {
+			style := row[x].style
+			var run strings.Builder
+			for ; x < len(row) && row[x].style == style; x++ {
+				if row[x].wide {
+					continue
+				}
+				run.WriteString(row[x].content)
+				raw = raw || strings.Contains(row[x].content, "\x1b")
+			}
+
+			if style == (cellStyle{}) {
+				out.WriteString(run.String())
+				continue
+			}
+			rendered, ok := styles[style]
+			if !ok {
+				rendered = style.lipgloss()
+				styles[style] = rendered
+			}
+			out.WriteString(rendered.Render(run.String()))
+		}
+
+		// Styling carried in by the caller's own escape sequences must not
+		// bleed into the next row.
+		if raw {
+			out.WriteString(ansi.ResetStyle)
+		}
+	}
+
+	return out.String()
+}
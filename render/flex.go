@@ -0,0 +1,461 @@
+package render
+
+import (
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// flexSpec describes a single item along a flex container's main axis before
+// free space has been resolved.
+type flexSpec struct {
+	basis  int
+	grow   int
+	shrink int
+	min    int
+	max    int // zero means unbounded
+}
+
+func (s flexSpec) clamp(size int) int {
+	if s.max > 0 && size > s.max {
+		size = s.max
+	}
+	if size < s.min {
+		size = s.min
+	}
+	if size < 0 {
+		size = 0
+	}
+	return size
+}
+
+func (s flexSpec) factor(growing bool) int {
+	if growing {
+		return s.grow
+	}
+	return s.shrink * s.basis
+}
+
+// resolveFlexSizes shares available cells across specs using the flexbox
+// constraint pass: every item starts at its clamped basis, free space is
+// handed out by grow weight (or taken back by shrink weight scaled by basis),
+// and items that violate their min or max are frozen at the bound before the
+// remainder is redistributed among the rest. Items may still overflow
+// available when their minimums demand it.
+func resolveFlexSizes(specs []flexSpec, available int) []int {
+	count := len(specs)
+	sizes := make([]int, count)
+	frozen := make([]bool, count)
+
+	hypothetical := 0
+	for i, spec := range specs {
+		sizes[i] = spec.clamp(spec.basis)
+		hypothetical += sizes[i]
+	}
+	if hypothetical == available {
+		return sizes
+	}
+
+	growing := hypothetical < available
+	for i, spec := range specs {
+		switch {
+		case spec.factor(growing) <= 0:
+			frozen[i] = true
+		case growing && spec.basis > sizes[i]:
+			frozen[i] = true
+		case !growing && spec.basis < sizes[i]:
+			frozen[i] = true
+		}
+	}
+
+	unfrozen := make([]int, 0, count)
+	weights := make([]int, 0, count)
+	violations := make([]int, count)
+
+	for {
+		unfrozen = unfrozen[:0]
+		weights = weights[:0]
+		free := available
+
+		for i, spec := range specs {
+			if frozen[i] {
+				free -= sizes[i]
+				continue
+			}
+			free -= spec.basis
+			unfrozen = append(unfrozen, i)
+			weights = append(weights, spec.factor(growing))
+		}
+
+		if len(unfrozen) == 0 {
+			return sizes
+		}
+
+		shares := distributeWeighted(free, weights)
+		totalViolation := 0
+		for j, idx := range unfrozen {
+			target := specs[idx].basis + shares[j]
+			sizes[idx] = specs[idx].clamp(target)
+			violations[idx] = sizes[idx] - target
+			totalViolation += violations[idx]
+		}
+
+		if totalViolation == 0 {
+			return sizes
+		}
+
+		for _, idx := range unfrozen {
+			if (totalViolation > 0 && violations[idx] > 0) || (totalViolation < 0 && violations[idx] < 0) {
+				frozen[idx] = true
+			}
+		}
+	}
+}
+
+// distributeWeighted splits amount (which may be negative) across weights.
+// Rounding leftovers go to the entries with the largest fractional parts,
+// earliest first on ties, so the shares always sum to amount.
+func distributeWeighted(amount int, weights []int) []int {
+	shares := make([]int, len(weights))
+	total := 0
+	for _, w := range weights {
+		if w > 0 {
+			total += w
+		}
+	}
+	if total == 0 || amount == 0 {
+		return shares
+	}
+
+	sign := 1
+	if amount < 0 {
+		sign = -1
+		amount = -amount
+	}
+
+	assigned := 0
+	for i, w := range weights {
+		if w > 0 {
+			shares[i] = amount * w / total
+			assigned += shares[i]
+		}
+	}
+
+	rounded := make([]bool, len(weights))
+	for assigned < amount {
+		best, bestRemainder := -1, -1
+		for i, w := range weights {
+			if w <= 0 || rounded[i] {
+				continue
+			}
+			if remainder := amount * w % total; remainder > bestRemainder {
+				best, bestRemainder = i, remainder
+			}
+		}
+		if best < 0 {
+			break
+		}
+		shares[best]++
+		rounded[best] = true
+		assigned++
+	}
+
+	for i := range shares {
+		shares[i] *= sign
+	}
+
+	return shares
+}
+
+func computeFlexWidths(flex bubbleviews.FlexNode, parentWidth int) []int {
+	count := len(flex.Items)
+	if count == 0 {
+		return make([]int, 0)
+	}
+
+	available := parentWidth
+	if available > 0 && count > 1 {
+		available -= flex.Spacing * (count - 1)
+		if available < 0 {
+			available = 0
+		}
+	}
+
+	specs := make([]flexSpec, count)
+	percents := make([]int, count)
+	flexible := make([]bool, count)
+	totalGrow := 0
+	for i, item := range flex.Items {
+		specs[i] = flexSpec{
+			shrink: item.Shrink,
+			min:    item.MinWidth,
+			max:    item.MaxWidth,
+		}
+		switch basis := itemBasis(item, bubbleviews.FlexDirectionRow); basis.Unit {
+		case bubbleviews.UnitCells:
+			specs[i].basis = basis.Value
+		case bubbleviews.UnitPercent:
+			percents[i] = basis.Value
+		case bubbleviews.UnitFraction:
+			specs[i].grow = basis.Value
+			totalGrow += basis.Value
+		default:
+			flexible[i] = true
+			if item.Grow > 0 {
+				specs[i].grow = item.Grow
+				totalGrow += item.Grow
+			}
+		}
+	}
+
+	for i, width := range resolvePercents(percents, available) {
+		if percents[i] > 0 {
+			specs[i].basis = width
+		}
+	}
+
+	for i, item := range flex.Items {
+		switch {
+		case !flexible[i]:
+		case totalGrow == 0:
+			// Without explicit weights every flexible item takes an equal share.
+			specs[i].grow = 1
+		case specs[i].grow == 0 && parentWidth > 0:
+			// Auto items beside growing ones keep their natural width, so the
+			// growing items only share what is left.
+			specs[i].basis = layoutNode(item.Node, bubbleviews.Size{}).outerWidth()
+		}
+	}
+	defaultShrink(specs)
+
+	if parentWidth <= 0 {
+		widths := make([]int, count)
+		for i, spec := range specs {
+			widths[i] = spec.clamp(spec.basis)
+		}
+		return widths
+	}
+
+	return resolveFlexSizes(specs, available)
+}
+
+// computeFlexHeights resolves the height of each item in a column; zero
+// leaves an item at its natural height. Parent height is only shared out when
+// an item declares Grow, Shrink, a relative basis or a height bound: fixed
+// heights and the natural height of the remaining items form the basis, and
+// the flex constraint pass resolves the rest. Items that had to be laid out
+// to learn their natural height are returned alongside so callers can reuse
+// them when the resolved height matches.
+func computeFlexHeights(flex bubbleviews.FlexNode, parentSize bubbleviews.Size) ([]int, []*LayoutNode) {
+	count := len(flex.Items)
+	heights := make([]int, count)
+	measured := make([]*LayoutNode, count)
+	bases := make([]bubbleviews.Dimension, count)
+	constrained := false
+
+	for i, item := range flex.Items {
+		bases[i] = itemBasis(item, bubbleviews.FlexDirectionColumn)
+		if bases[i].Unit == bubbleviews.UnitCells {
+			heights[i] = bases[i].Value
+		}
+		if item.Grow > 0 || item.Shrink > 0 || item.MinHeight > 0 || item.MaxHeight > 0 ||
+			bases[i].Unit == bubbleviews.UnitPercent || bases[i].Unit == bubbleviews.UnitFraction {
+			constrained = true
+		}
+	}
+
+	if !constrained {
+		return heights, measured
+	}
+
+	available := parentSize.Height
+	if count > 1 && flex.Spacing != 0 {
+		available -= flex.Spacing * (count - 1)
+	}
+	if available < 0 {
+		available = 0
+	}
+
+	percents := make([]int, count)
+	for i, basis := range bases {
+		if basis.Unit == bubbleviews.UnitPercent {
+			percents[i] = basis.Value
+		}
+	}
+	resolvedPercents := resolvePercents(percents, available)
+
+	specs := make([]flexSpec, count)
+	for i, item := range flex.Items {
+		specs[i] = flexSpec{
+			shrink: item.Shrink,
+			min:    item.MinHeight,
+			max:    item.MaxHeight,
+		}
+		switch {
+		case bases[i].Unit == bubbleviews.UnitCells:
+			specs[i].basis = bases[i].Value
+		case bases[i].Unit == bubbleviews.UnitPercent && available > 0:
+			specs[i].basis = resolvedPercents[i]
+		case bases[i].Unit == bubbleviews.UnitFraction:
+			specs[i].grow = bases[i].Value
+		case bases[i].Unit == bubbleviews.UnitAuto && item.Grow > 0:
+			specs[i].grow = item.Grow
+		default:
+			natural := layoutNode(item.Node, bubbleviews.Size{Width: parentSize.Width})
+			measured[i] = &natural
+			specs[i].basis = natural.outerHeight()
+		}
+	}
+	defaultShrink(specs)
+
+	if parentSize.Height <= 0 {
+		for i, spec := range specs {
+			heights[i] = spec.clamp(spec.basis)
+		}
+		return heights, measured
+	}
+
+	return resolveFlexSizes(specs, available), measured
+}
+
+// itemBasis reports the main-axis size an item asks for, folding the plain
+// Width/Height fields into a Dimension when Basis is left as auto.
+func itemBasis(item bubbleviews.FlexItem, direction bubbleviews.FlexDirection) bubbleviews.Dimension {
+	if item.Basis.Unit != bubbleviews.UnitAuto {
+		return item.Basis
+	}
+
+	size := item.Width
+	if direction == bubbleviews.FlexDirectionColumn {
+		size = item.Height
+	}
+	if size > 0 {
+		return bubbleviews.Cells(size)
+	}
+
+	return bubbleviews.Dimension{}
+}
+
+// resolvePercents converts percentages of available into cells. Rounding
+// leftovers go to the entries with the largest fractional parts so that, for
+// example, 50/25/25 always adds up to exactly available.
+func resolvePercents(percents []int, available int) []int {
+	sizes := make([]int, len(percents))
+	if available <= 0 {
+		return sizes
+	}
+
+	totalPercent := 0
+	assigned := 0
+	for i, p := range percents {
+		if p <= 0 {
+			continue
+		}
+		sizes[i] = available * p / 100
+		assigned += sizes[i]
+		totalPercent += p
+	}
+
+	target := available * totalPercent / 100
+	rounded := make([]bool, len(percents))
+	for assigned < target {
+		best, bestRemainder := -1, -1
+		for i, p := range percents {
+			if p <= 0 || rounded[i] {
+				continue
+			}
+			if remainder := available * p % 100; remainder > bestRemainder {
+				best, bestRemainder = i, remainder
+			}
+		}
+		if best < 0 {
+			break
+		}
+		sizes[best]++
+		rounded[best] = true
+		assigned++
+	}
+
+	return sizes
+}
+
+// defaultShrink lets every item shrink evenly when none declares a weight.
+func defaultShrink(specs []flexSpec) {
+	for _, spec := range specs {
+		if spec.shrink > 0 {
+			return
+		}
+	}
+	for i := range specs {
+		specs[i].shrink = 1
+	}
+}
+
+// itemAlign resolves the cross-axis alignment for a single flex item.
+func itemAlign(flex bubbleviews.FlexNode, item bubbleviews.FlexItem) bubbleviews.FlexAlign {
+	if item.AlignSelf != "" {
+		return item.AlignSelf
+	}
+	return flex.AlignItems
+}
+
+// stretchNode returns node configured to fill the cross axis of a flex
+// container. Only boxes carry fill rules; other nodes are returned unchanged.
+func stretchNode(node bubbleviews.Node, direction bubbleviews.FlexDirection) bubbleviews.Node {
+	var box bubbleviews.BoxNode
+	switch n := node.(type) {
+	case bubbleviews.BoxNode:
+		box = n
+	case *bubbleviews.BoxNode:
+		box = *n
+	default:
+		return node
+	}
+
+	if direction == bubbleviews.FlexDirectionColumn {
+		box.Style.FillWidth = true
+	} else {
+		box.Style.FillHeight = true
+	}
+	return box
+}
+
+// justifyGaps splits free main-axis space into a leading gap and the extra
+// gaps inserted between consecutive items. Any space not handed out trails
+// the last item.
+func justifyGaps(justify bubbleviews.FlexJustify, free, count int) (int, []int) {
+	between := make([]int, max(count-1, 0))
+	if free <= 0 || count == 0 {
+		return 0, between
+	}
+
+	switch justify {
+	case bubbleviews.FlexJustifyEnd:
+		return free, between
+	case bubbleviews.FlexJustifyCenter:
+		return free / 2, between
+	case bubbleviews.FlexJustifySpaceBetween:
+		if count > 1 {
+			copy(between, distributeWeighted(free, equalWeights(count-1)))
+		}
+		return 0, between
+	case bubbleviews.FlexJustifySpaceAround:
+		halves := distributeWeighted(free, equalWeights(count*2))
+		for i := range between {
+			between[i] = halves[i*2+1] + halves[i*2+2]
+		}
+		return halves[0], between
+	case bubbleviews.FlexJustifySpaceEvenly:
+		slots := distributeWeighted(free, equalWeights(count+1))
+		copy(between, slots[1:count])
+		return slots[0], between
+	default:
+		return 0, between
+	}
+}
+
+func equalWeights(count int) []int {
+	weights := make([]int, count)
+	for i := range weights {
+		weights[i] = 1
+	}
+	return weights
+}
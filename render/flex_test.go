@@ -0,0 +1,226 @@
+package render
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func TestDistributeWeighted(t *testing.T) {
+	tests := []struct {
+		amount  int
+		weights []int
+		want    []int
+	}{
+		{amount: 40, weights: []int{1, 2, 1}, want: []int{10, 20, 10}},
+		// 2.5/5/2.5: the leftover cell goes to the first of the tied remainders.
+		{amount: 10, weights: []int{1, 2, 1}, want: []int{3, 5, 2}},
+		{amount: -10, weights: []int{1, 2, 1}, want: []int{-3, -5, -2}},
+		{amount: 7, weights: []int{1, 1, 1}, want: []int{3, 2, 2}},
+		{amount: 5, weights: []int{0, 1, 0}, want: []int{0, 5, 0}},
+		{amount: 5, weights: []int{0, 0}, want: []int{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.amount, tt.weights), func(t *testing.T) {
+			got := distributeWeighted(tt.amount, tt.weights)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResolveFlexSizes(t *testing.T) {
+	tests := []struct {
+		name      string
+		specs     []flexSpec
+		available int
+		want      []int
+	}{
+		{
+			name:      "exact fit keeps bases",
+			specs:     []flexSpec{{basis: 5, grow: 1}, {basis: 5, grow: 1}},
+			available: 10,
+			want:      []int{5, 5},
+		},
+		{
+			name:      "items without grow stay at their basis",
+			specs:     []flexSpec{{basis: 4}, {basis: 0, grow: 1}},
+			available: 10,
+			want:      []int{4, 6},
+		},
+		{
+			name:      "max freezes and the rest is redistributed",
+			specs:     []flexSpec{{grow: 1, max: 5}, {grow: 1}},
+			available: 20,
+			want:      []int{5, 15},
+		},
+		{
+			name:      "min freezes while shrinking",
+			specs:     []flexSpec{{basis: 10, shrink: 1, min: 8}, {basis: 10, shrink: 1}},
+			available: 12,
+			want:      []int{8, 4},
+		},
+		{
+			name:      "shrink is weighted by basis",
+			specs:     []flexSpec{{basis: 30, shrink: 1}, {basis: 10, shrink: 1}},
+			available: 20,
+			want:      []int{15, 5},
+		},
+		{
+			name:      "items without shrink keep their basis",
+			specs:     []flexSpec{{basis: 10}, {basis: 10, shrink: 1}},
+			available: 15,
+			want:      []int{10, 5},
+		},
+		{
+			name:      "minimums overflow the available space",
+			specs:     []flexSpec{{basis: 10, shrink: 1, min: 10}, {basis: 10, shrink: 1, min: 9}},
+			available: 12,
+			want:      []int{10, 9},
+		},
+		{
+			name:      "a basis above max is clamped before growing",
+			specs:     []flexSpec{{basis: 12, grow: 1, max: 6}, {grow: 1}},
+			available: 20,
+			want:      []int{6, 14},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveFlexSizes(tt.specs, tt.available)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFlexColumnGrowSplitsHeightByWeight(t *testing.T) {
+	tests := []struct {
+		height int
+		want   []int // y and height of each item
+	}{
+		{height: 40, want: []int{0, 10, 10, 20, 30, 10}},
+		{height: 10, want: []int{0, 3, 3, 5, 8, 2}},
+	}
+
+	for _, tt := range tests {
+		laid := Layout(bubbleviews.View{
+			Size: bubbleviews.Size{Width: 10, Height: tt.height},
+			Children: []bubbleviews.Node{bubbleviews.FlexNode{
+				Direction: bubbleviews.FlexDirectionColumn,
+				Items: []bubbleviews.FlexItem{
+					{Node: fillHeightBox("a"), Grow: 1},
+					{Node: fillHeightBox("b"), Grow: 2},
+					{Node: fillHeightBox("c"), Grow: 1},
+				},
+			}},
+		})
+
+		var got []int
+		for _, child := range laid.Nodes[0].Children {
+			got = append(got, child.Rect.Y, child.Rect.Height)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Fatalf("height %d: expected y/height pairs %v, got %v", tt.height, tt.want, got)
+		}
+	}
+}
+
+func fillHeightBox(label string) bubbleviews.BoxNode {
+	return bubbleviews.BoxNode{
+		Style:   bubbleviews.BoxStyle{FillHeight: true},
+		Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: label}}},
+	}
+}
+
+func TestFlexJustifyOffsets(t *testing.T) {
+	// Three one-cell items in a row of ten leave seven free cells, which no
+	// justify value can split evenly.
+	tests := []struct {
+		justify bubbleviews.FlexJustify
+		want    []int
+	}{
+		{justify: "", want: []int{0, 1, 2}},
+		{justify: bubbleviews.FlexJustifyStart, want: []int{0, 1, 2}},
+		{justify: bubbleviews.FlexJustifyEnd, want: []int{7, 8, 9}},
+		{justify: bubbleviews.FlexJustifyCenter, want: []int{3, 4, 5}},
+		{justify: bubbleviews.FlexJustifySpaceBetween, want: []int{0, 5, 9}},
+		{justify: bubbleviews.FlexJustifySpaceAround, want: []int{2, 5, 8}},
+		{justify: bubbleviews.FlexJustifySpaceEvenly, want: []int{2, 5, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.justify), func(t *testing.T) {
+			items := make([]bubbleviews.FlexItem, 3)
+			for i := range items {
+				items[i] = bubbleviews.FlexItem{Node: bubbleviews.TextNode{Value: "x"}, Basis: bubbleviews.Cells(1)}
+			}
+			laid := Layout(bubbleviews.View{
+				Size:     bubbleviews.Size{Width: 10, Height: 1},
+				Children: []bubbleviews.Node{bubbleviews.FlexNode{Justify: tt.justify, Items: items}},
+			})
+
+			var got []int
+			for _, child := range laid.Nodes[0].Children {
+				got = append(got, child.Rect.X)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("expected x offsets %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFlexAlignSelfOverridesAlignItems(t *testing.T) {
+	laid := Layout(bubbleviews.View{
+		Size: bubbleviews.Size{Width: 20, Height: 5},
+		Children: []bubbleviews.Node{bubbleviews.FlexNode{
+			AlignItems: bubbleviews.FlexAlignEnd,
+			Items: []bubbleviews.FlexItem{
+				{Node: bubbleviews.TextNode{Value: "1\n2\n3\n4\n5"}, Width: 4},
+				{Node: bubbleviews.TextNode{Value: "end"}, Width: 4},
+				{Node: bubbleviews.TextNode{Value: "top"}, Width: 4, AlignSelf: bubbleviews.FlexAlignStart},
+				{Node: bubbleviews.TextNode{Value: "mid"}, Width: 4, AlignSelf: bubbleviews.FlexAlignCenter},
+				{Node: bubbleviews.BoxNode{}, Width: 4, AlignSelf: bubbleviews.FlexAlignStretch},
+			},
+		}},
+	})
+
+	var got []int
+	for _, child := range laid.Nodes[0].Children {
+		got = append(got, child.Rect.Y, child.Rect.Height)
+	}
+	want := []int{0, 5, 4, 1, 0, 1, 2, 1, 0, 5}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected y/height pairs %v, got %v", want, got)
+	}
+}
+
+func TestFlexRowAutoItemsKeepNaturalWidthBesideFractions(t *testing.T) {
+	laid := Layout(bubbleviews.View{
+		Size: bubbleviews.Size{Width: 30, Height: 1},
+		Children: []bubbleviews.Node{bubbleviews.FlexNode{
+			Items: []bubbleviews.FlexItem{
+				{Node: bubbleviews.TextNode{Value: "fraction"}, Basis: bubbleviews.Fraction(1)},
+				{Node: bubbleviews.TextNode{Value: "autoitem"}},
+			},
+		}},
+	})
+
+	row := laid.Nodes[0]
+	var got []int
+	for _, child := range row.Children {
+		got = append(got, child.Rect.X, child.Rect.Width)
+	}
+	if want := []int{0, 22, 22, 8}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected x/width pairs %v, got %v", want, got)
+	}
+	if row.Rect.Width != 30 {
+		t.Fatalf("expected the row to fill its 30 cells, got %d", row.Rect.Width)
+	}
+}
@@ -0,0 +1,181 @@
+package render
+
+import (
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func layoutGrid(grid bubbleviews.GridNode, parentSize bubbleviews.Size) LayoutNode {
+	if len(grid.Cells) == 0 {
+		return LayoutNode{}
+	}
+
+	cells := normalizeGridCells(grid.Cells)
+	columnCount, rowCount := len(grid.Columns), len(grid.Rows)
+	for _, cell := range cells {
+		columnCount = max(columnCount, cell.Column+cell.ColSpan)
+		rowCount = max(rowCount, cell.Row+cell.RowSpan)
+	}
+
+	columnGap := max(grid.ColumnGap, 0)
+	rowGap := max(grid.RowGap, 0)
+	if grid.CollapseBorders {
+		// Neighbouring tracks overlap by a cell so cell borders share lines.
+		columnGap, rowGap = -1, -1
+	}
+
+	widths := resolveGridTracks(gridTracks(grid.Columns, columnCount), parentSize.Width, columnGap, func(track int) int {
+		natural := 0
+		for _, cell := range cells {
+			if cell.Column == track && cell.ColSpan == 1 {
+				natural = max(natural, layoutNode(cell.Node, bubbleviews.Size{}).outerWidth())
+			}
+		}
+		return natural
+	})
+
+	// Cells measured for their natural height are kept so the final pass can
+	// reuse them instead of laying out every nested level again.
+	measured := make([]*LayoutNode, len(cells))
+	heights := resolveGridTracks(gridTracks(grid.Rows, rowCount), parentSize.Height, rowGap, func(track int) int {
+		natural := 0
+		for i, cell := range cells {
+			if cell.Row == track && cell.RowSpan == 1 {
+				size := bubbleviews.Size{Width: spanLength(widths, cell.Column, cell.ColSpan, columnGap)}
+				laid := layoutNode(cell.Node, size)
+				measured[i] = &laid
+				natural = max(natural, laid.outerHeight())
+			}
+		}
+		return natural
+	})
+
+	xs := trackOffsets(widths, columnGap)
+	ys := trackOffsets(heights, rowGap)
+	children := make([]LayoutNode, len(cells))
+
+	for i, cell := range cells {
+		slot := Rect{
+			X:      xs[cell.Column],
+			Y:      ys[cell.Row],
+			Width:  spanLength(widths, cell.Column, cell.ColSpan, columnGap),
+			Height: spanLength(heights, cell.Row, cell.RowSpan, rowGap),
+		}
+		if measured[i] != nil && measured[i].outerHeight() == slot.Height {
+			children[i] = *measured[i]
+		} else {
+			children[i] = layoutNode(cell.Node, bubbleviews.Size{Width: slot.Width, Height: slot.Height})
+		}
+		children[i].place(slot.X, slot.Y)
+		children[i].clip = &slot
+	}
+	if grid.CollapseBorders {
+		joinBorders(children)
+	}
+
+	return LayoutNode{
+		Rect: Rect{
+			Width:  spanLength(widths, 0, len(widths), columnGap),
+			Height: spanLength(heights, 0, len(heights), rowGap),
+		},
+		Children: children,
+	}
+}
+
+// normalizeGridCells drops empty cells and clamps positions and spans to
+// usable values.
+func normalizeGridCells(cells []bubbleviews.GridCell) []bubbleviews.GridCell {
+	normalized := make([]bubbleviews.GridCell, 0, len(cells))
+	for _, cell := range cells {
+		if cell.Node == nil {
+			continue
+		}
+		cell.Row = max(cell.Row, 0)
+		cell.Column = max(cell.Column, 0)
+		cell.RowSpan = max(cell.RowSpan, 1)
+		cell.ColSpan = max(cell.ColSpan, 1)
+		normalized = append(normalized, cell)
+	}
+	return normalized
+}
+
+// gridTracks pads the declared tracks with auto tracks up to count.
+func gridTracks(declared []bubbleviews.Dimension, count int) []bubbleviews.Dimension {
+	tracks := make([]bubbleviews.Dimension, count)
+	copy(tracks, declared)
+	return tracks
+}
+
+// resolveGridTracks sizes tracks along one axis. Fixed and percentage tracks
+// are settled first, auto tracks take the natural size of the cells that sit
+// entirely inside them, and fraction tracks share whatever parent space is
+// left. Without a parent size, fraction and percentage tracks behave as auto.
+func resolveGridTracks(tracks []bubbleviews.Dimension, parent, gap int, natural func(track int) int) []int {
+	sizes := make([]int, len(tracks))
+	if len(tracks) == 0 {
+		return sizes
+	}
+
+	available := 0
+	if parent > 0 {
+		available = max(parent-gap*(len(tracks)-1), 0)
+	}
+
+	percents := make([]int, len(tracks))
+	for i, track := range tracks {
+		if track.Unit == bubbleviews.UnitPercent {
+			percents[i] = track.Value
+		}
+	}
+	resolvedPercents := resolvePercents(percents, available)
+
+	weights := make([]int, len(tracks))
+	used := 0
+	for i, track := range tracks {
+		switch {
+		case track.Unit == bubbleviews.UnitCells:
+			sizes[i] = max(track.Value, 0)
+		case track.Unit == bubbleviews.UnitPercent && parent > 0:
+			sizes[i] = resolvedPercents[i]
+		case track.Unit == bubbleviews.UnitFraction && parent > 0:
+			weights[i] = track.Value
+			continue
+		default:
+			sizes[i] = natural(i)
+		}
+		used += sizes[i]
+	}
+
+	if parent > 0 {
+		shares := distributeWeighted(max(available-used, 0), weights)
+		for i, weight := range weights {
+			if weight > 0 {
+				sizes[i] = shares[i]
+			}
+		}
+	}
+
+	return sizes
+}
+
+func trackOffsets(sizes []int, gap int) []int {
+	offsets := make([]int, len(sizes))
+	position := 0
+	for i, size := range sizes {
+		offsets[i] = position
+		position += size + gap
+	}
+	return offsets
+}
+
+// spanLength measures count tracks starting at start, including the gaps
+// between them.
+func spanLength(sizes []int, start, count, gap int) int {
+	length := 0
+	for i := start; i < start+count && i < len(sizes); i++ {
+		if i > start {
+			length += gap
+		}
+		length += sizes[i]
+	}
+	return length
+}
@@ -0,0 +1,104 @@
+package render
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func gridCellRects(laid LayoutNode) []Rect {
+	rects := make([]Rect, len(laid.Children))
+	for i, child := range laid.Children {
+		rects[i] = child.Rect
+	}
+	return rects
+}
+
+func gridBox(label string) bubbleviews.BoxNode {
+	return bubbleviews.BoxNode{
+		Style:   bubbleviews.BoxStyle{FillWidth: true, FillHeight: true},
+		Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: label}}},
+	}
+}
+
+func TestGridTrackSizing(t *testing.T) {
+	laid := Layout(bubbleviews.View{
+		Size: bubbleviews.Size{Width: 40, Height: 12},
+		Children: []bubbleviews.Node{bubbleviews.GridNode{
+			Columns:   []bubbleviews.Dimension{bubbleviews.Cells(6), bubbleviews.Fraction(1), {}, bubbleviews.Fraction(2)},
+			Rows:      []bubbleviews.Dimension{{}, bubbleviews.Cells(3), bubbleviews.Fraction(1)},
+			ColumnGap: 1,
+			RowGap:    1,
+			Cells: []bubbleviews.GridCell{
+				{Node: gridBox("fixed"), Column: 0},
+				{Node: gridBox("fr"), Column: 1},
+				{Node: gridBox("auto"), Column: 2},
+				{Node: gridBox("fr2"), Column: 3},
+				{Node: gridBox("two\nlines"), Row: 1},
+				{Node: gridBox("rest"), Row: 2},
+			},
+		}},
+	})
+
+	// 40 cells less three gaps, 6 fixed and 4 auto leave 27 for 1fr:2fr.
+	want := []Rect{
+		{X: 0, Y: 0, Width: 6, Height: 1},
+		{X: 7, Y: 0, Width: 9, Height: 1},
+		{X: 17, Y: 0, Width: 4, Height: 1},
+		{X: 22, Y: 0, Width: 18, Height: 1},
+		{X: 0, Y: 2, Width: 6, Height: 3},
+		{X: 0, Y: 6, Width: 6, Height: 6},
+	}
+	if got := gridCellRects(laid.Nodes[0]); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected cells\n%v\ngot\n%v", want, got)
+	}
+}
+
+func TestGridSpansCoverTracksAndGaps(t *testing.T) {
+	laid := Layout(bubbleviews.View{
+		Size: bubbleviews.Size{Width: 32, Height: 9},
+		Children: []bubbleviews.Node{bubbleviews.GridNode{
+			Columns:   []bubbleviews.Dimension{bubbleviews.Fraction(1), bubbleviews.Fraction(1), bubbleviews.Fraction(1)},
+			Rows:      []bubbleviews.Dimension{bubbleviews.Fraction(1), bubbleviews.Fraction(1), bubbleviews.Fraction(1)},
+			ColumnGap: 1,
+			RowGap:    0,
+			Cells: []bubbleviews.GridCell{
+				{Node: gridBox("chart"), ColSpan: 2, RowSpan: 2},
+				{Node: gridBox("side"), Column: 2, RowSpan: 3},
+				{Node: gridBox("footer"), Row: 2, ColSpan: 2},
+			},
+		}},
+	})
+
+	want := []Rect{
+		{X: 0, Y: 0, Width: 21, Height: 6},
+		{X: 22, Y: 0, Width: 10, Height: 9},
+		{X: 0, Y: 6, Width: 21, Height: 3},
+	}
+	if got := gridCellRects(laid.Nodes[0]); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected cells\n%v\ngot\n%v", want, got)
+	}
+}
+
+func TestNestedGridsLayOutEachCellOnce(t *testing.T) {
+	built := 0
+	var node bubbleviews.Node = bubbleviews.VirtualListNode{
+		Count: 1,
+		Row: func(int) bubbleviews.Node {
+			built++
+			return bubbleviews.TextNode{Value: "leaf"}
+		},
+	}
+	for range 4 {
+		node = bubbleviews.GridNode{
+			Columns: []bubbleviews.Dimension{bubbleviews.Fraction(1)},
+			Cells:   []bubbleviews.GridCell{{Node: node}},
+		}
+	}
+
+	Layout(bubbleviews.View{Size: bubbleviews.Size{Width: 20}, Children: []bubbleviews.Node{node}})
+	if built != 1 {
+		t.Fatalf("expected the leaf to be built once, got %d", built)
+	}
+}
@@ -0,0 +1,47 @@
+package render
+
+import "github.com/sprucelabsai-community/bubbleviews"
+
+// At returns the nodes under the cell at x, y, outermost first and ending at
+// the deepest match. Later siblings sit above earlier ones, matching paint
+// order, and parts of a node clipped away by its parent never hit it.
+func (t LayoutTree) At(x, y int) []LayoutNode {
+	bounds := Rect{Width: t.Size.Width, Height: t.Size.Height}
+	return hitNodes(t.Nodes, x, y, bounds)
+}
+
+func hitNodes(nodes []LayoutNode, x, y int, clip Rect) []LayoutNode {
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node := nodes[i]
+		visible := clip
+		if node.clip != nil {
+			visible = visible.intersect(*node.clip)
+		}
+		if !visible.Contains(x, y) || !node.Rect.Contains(x, y) {
+			continue
+		}
+		return append([]LayoutNode{node}, hitNodes(node.Children, x, y, visible)...)
+	}
+	return nil
+}
+
+// Find returns the laid-out node with the given ID, searching depth-first in
+// paint order.
+func (t LayoutTree) Find(id string) (LayoutNode, bool) {
+	if id == "" {
+		return LayoutNode{}, false
+	}
+	return findLaidOut(t.Nodes, id)
+}
+
+func findLaidOut(nodes []LayoutNode, id string) (LayoutNode, bool) {
+	for _, node := range nodes {
+		if bubbleviews.NodeID(node.Node) == id {
+			return node, true
+		}
+		if found, ok := findLaidOut(node.Children, id); ok {
+			return found, true
+		}
+	}
+	return LayoutNode{}, false
+}
@@ -0,0 +1,83 @@
+package render
+
+// junction records the weight of the line leaving a box-drawing glyph towards
+// the top, right, bottom and left edges of its cell. Zero means no line, then
+// light, heavy and double.
+type junction [4]uint8
+
+// boxGlyphs lists the box-drawing glyphs that can be merged, in code point
+// order so the plain corners win over the rounded ones when a junction is
+// turned back into a glyph. Dashed and diagonal lines are left out.
+var boxGlyphs = []struct {
+	glyph string
+	arms  junction
+}{
+	{"─", junction{0, 1, 0, 1}}, {"━", junction{0, 2, 0, 2}}, {"│", junction{1, 0, 1, 0}}, {"┃", junction{2, 0, 2, 0}},
+	{"┌", junction{0, 1, 1, 0}}, {"┍", junction{0, 2, 1, 0}}, {"┎", junction{0, 1, 2, 0}}, {"┏", junction{0, 2, 2, 0}},
+	{"┐", junction{0, 0, 1, 1}}, {"┑", junction{0, 0, 1, 2}}, {"┒", junction{0, 0, 2, 1}}, {"┓", junction{0, 0, 2, 2}},
+	{"└", junction{1, 1, 0, 0}}, {"┕", junction{1, 2, 0, 0}}, {"┖", junction{2, 1, 0, 0}}, {"┗", junction{2, 2, 0, 0}},
+	{"┘", junction{1, 0, 0, 1}}, {"┙", junction{1, 0, 0, 2}}, {"┚", junction{2, 0, 0, 1}}, {"┛", junction{2, 0, 0, 2}},
+	{"├", junction{1, 1, 1, 0}}, {"┝", junction{1, 2, 1, 0}}, {"┞", junction{2, 1, 1, 0}}, {"┟", junction{1, 1, 2, 0}},
+	{"┠", junction{2, 1, 2, 0}}, {"┡", junction{2, 2, 1, 0}}, {"┢", junction{1, 2, 2, 0}}, {"┣", junction{2, 2, 2, 0}},
+	{"┤", junction{1, 0, 1, 1}}, {"┥", junction{1, 0, 1, 2}}, {"┦", junction{2, 0, 1, 1}}, {"┧", junction{1, 0, 2, 1}},
+	{"┨", junction{2, 0, 2, 1}}, {"┩", junction{2, 0, 1, 2}}, {"┪", junction{1, 0, 2, 2}}, {"┫", junction{2, 0, 2, 2}},
+	{"┬", junction{0, 1, 1, 1}}, {"┭", junction{0, 1, 1, 2}}, {"┮", junction{0, 2, 1, 1}}, {"┯", junction{0, 2, 1, 2}},
+	{"┰", junction{0, 1, 2, 1}}, {"┱", junction{0, 1, 2, 2}}, {"┲", junction{0, 2, 2, 1}}, {"┳", junction{0, 2, 2, 2}},
+	{"┴", junction{1, 1, 0, 1}}, {"┵", junction{1, 1, 0, 2}}, {"┶", junction{1, 2, 0, 1}}, {"┷", junction{1, 2, 0, 2}},
+	{"┸", junction{2, 1, 0, 1}}, {"┹", junction{2, 1, 0, 2}}, {"┺", junction{2, 2, 0, 1}}, {"┻", junction{2, 2, 0, 2}},
+	{"┼", junction{1, 1, 1, 1}}, {"┽", junction{1, 1, 1, 2}}, {"┾", junction{1, 2, 1, 1}}, {"┿", junction{1, 2, 1, 2}},
+	{"╀", junction{2, 1, 1, 1}}, {"╁", junction{1, 1, 2, 1}}, {"╂", junction{2, 1, 2, 1}}, {"╃", junction{2, 1, 1, 2}},
+	{"╄", junction{2, 2, 1, 1}}, {"╅", junction{1, 1, 2, 2}}, {"╆", junction{1, 2, 2, 1}}, {"╇", junction{2, 2, 1, 2}},
+	{"╈", junction{1, 2, 2, 2}}, {"╉", junction{2, 1, 2, 2}}, {"╊", junction{2, 2, 2, 1}}, {"╋", junction{2, 2, 2, 2}},
+	{"═", junction{0, 3, 0, 3}}, {"║", junction{3, 0, 3, 0}}, {"╒", junction{0, 3, 1, 0}}, {"╓", junction{0, 1, 3, 0}},
+	{"╔", junction{0, 3, 3, 0}}, {"╕", junction{0, 0, 1, 3}}, {"╖", junction{0, 0, 3, 1}}, {"╗", junction{0, 0, 3, 3}},
+	{"╘", junction{1, 3, 0, 0}}, {"╙", junction{3, 1, 0, 0}}, {"╚", junction{3, 3, 0, 0}}, {"╛", junction{1, 0, 0, 3}},
+	{"╜", junction{3, 0, 0, 1}}, {"╝", junction{3, 0, 0, 3}}, {"╞", junction{1, 3, 1, 0}}, {"╟", junction{3, 1, 3, 0}},
+	{"╠", junction{3, 3, 3, 0}}, {"╡", junction{1, 0, 1, 3}}, {"╢", junction{3, 0, 3, 1}}, {"╣", junction{3, 0, 3, 3}},
+	{"╤", junction{0, 3, 1, 3}}, {"╥", junction{0, 1, 3, 1}}, {"╦", junction{0, 3, 3, 3}}, {"╧", junction{1, 3, 0, 3}},
+	{"╨", junction{3, 1, 0, 1}}, {"╩", junction{3, 3, 0, 3}}, {"╪", junction{1, 3, 1, 3}}, {"╫", junction{3, 1, 3, 1}},
+	{"╬", junction{3, 3, 3, 3}}, {"╭", junction{0, 1, 1, 0}}, {"╮", junction{0, 0, 1, 1}}, {"╯", junction{1, 0, 0, 1}},
+	{"╰", junction{1, 1, 0, 0}}, {"╴", junction{0, 0, 0, 1}}, {"╵", junction{1, 0, 0, 0}}, {"╶", junction{0, 1, 0, 0}},
+	{"╷", junction{0, 0, 1, 0}}, {"╸", junction{0, 0, 0, 2}}, {"╹", junction{2, 0, 0, 0}}, {"╺", junction{0, 2, 0, 0}},
+	{"╻", junction{0, 0, 2, 0}}, {"╼", junction{0, 2, 0, 1}}, {"╽", junction{1, 0, 2, 0}}, {"╾", junction{0, 1, 0, 2}},
+	{"╿", junction{2, 0, 1, 0}},
+}
+
+var glyphJunctions, junctionGlyphs = indexBoxGlyphs()
+
+func indexBoxGlyphs() (map[string]junction, map[junction]string) {
+	arms := make(map[string]junction, len(boxGlyphs))
+	glyphs := make(map[junction]string, len(boxGlyphs))
+	for _, entry := range boxGlyphs {
+		arms[entry.glyph] = entry.arms
+		if _, ok := glyphs[entry.arms]; !ok {
+			glyphs[entry.arms] = entry.glyph
+		}
+	}
+	return arms, glyphs
+}
+
+// joinGlyphs returns the glyph drawn where line crosses the existing glyph in
+// a cell, such as ┬ where two boxes share a top corner. Arms present in both
+// take the weight of line. When either glyph is not a line, or Unicode has no
+// glyph for the combination (heavy meeting double, say), line wins outright.
+func joinGlyphs(existing, line string) string {
+	under, ok := glyphJunctions[existing]
+	if !ok {
+		return line
+	}
+	over, ok := glyphJunctions[line]
+	if !ok {
+		return line
+	}
+
+	for i, weight := range over {
+		if weight != 0 {
+			under[i] = weight
+		}
+	}
+	if glyph, ok := junctionGlyphs[under]; ok {
+		return glyph
+	}
+	return line
+}
@@ -0,0 +1,104 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func TestJoinGlyphs(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		line     string
+		want     string
+	}{
+		{name: "light lines cross", existing: "─", line: "│", want: "┼"},
+		{name: "light over heavy", existing: "┃", line: "─", want: "╂"},
+		{name: "light arms replace heavy ones", existing: "┏", line: "┌", want: "┌"},
+		{name: "heavy over light", existing: "│", line: "━", want: "┿"},
+		{name: "light over double", existing: "║", line: "─", want: "╫"},
+		{name: "double over light", existing: "┬", line: "═", want: "╤"},
+		{name: "heavy meeting double", existing: "║", line: "━", want: "━"},
+		{name: "rounded corner under a straight line", existing: "╭", line: "│", want: "├"},
+		{name: "rounded corner over a straight line", existing: "─", line: "╮", want: "┬"},
+		{name: "rounded corners meet", existing: "╮", line: "╭", want: "┬"},
+		{name: "line over text", existing: "a", line: "─", want: "─"},
+		{name: "text over a line", existing: "─", line: "x", want: "x"},
+		{name: "line over a blank cell", existing: " ", line: "│", want: "│"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := joinGlyphs(tt.existing, tt.line); got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+// collapsedBox is a bordered box that fills the slot a collapsing container
+// gives it.
+func collapsedBox(label string) bubbleviews.BoxNode {
+	return bubbleviews.BoxNode{
+		Style:   bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, FillWidth: true, FillHeight: true},
+		Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: label}}},
+	}
+}
+
+func TestCollapsedGridJoinsBorders(t *testing.T) {
+	view := bubbleviews.View{
+		Size: bubbleviews.Size{Width: 9, Height: 5},
+		Children: []bubbleviews.Node{bubbleviews.GridNode{
+			Columns:         []bubbleviews.Dimension{bubbleviews.Fraction(1), bubbleviews.Fraction(1)},
+			Rows:            []bubbleviews.Dimension{bubbleviews.Fraction(1), bubbleviews.Fraction(1)},
+			CollapseBorders: true,
+			Cells: []bubbleviews.GridCell{
+				{Node: collapsedBox("a")},
+				{Node: collapsedBox("b"), Column: 1},
+				{Node: collapsedBox("c"), Row: 1},
+				{Node: collapsedBox("d"), Row: 1, Column: 1},
+			},
+		}},
+	}
+
+	want := strings.Join([]string{
+		"┌───┬───┐",
+		"│a  │b  │",
+		"├───┼───┤",
+		"│c  │d  │",
+		"└───┴───┘",
+	}, "\n")
+	if got := Render(view); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestCollapsedColumnJoinsBorders(t *testing.T) {
+	view := bubbleviews.View{
+		Size: bubbleviews.Size{Width: 7},
+		Children: []bubbleviews.Node{bubbleviews.FlexNode{
+			Direction:       bubbleviews.FlexDirectionColumn,
+			CollapseBorders: true,
+			Items: []bubbleviews.FlexItem{
+				{Node: collapsedBox("dock")},
+				{Node: collapsedBox("lobby")},
+				{Node: collapsedBox("yard")},
+			},
+		}},
+	}
+
+	want := strings.Join([]string{
+		"┌─────┐",
+		"│dock │",
+		"├─────┤",
+		"│lobby│",
+		"├─────┤",
+		"│yard │",
+		"└─────┘",
+	}, "\n")
+	if got := Render(view); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+}
@@ -0,0 +1,43 @@
+package render
+
+import (
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// layoutLayers stacks each layer over the previous ones. The stack fills the
+// parent size when one is given and otherwise takes the base layer's size;
+// layers reaching past the stack are clipped.
+func layoutLayers(stack bubbleviews.LayerNode, parentSize bubbleviews.Size) LayoutNode {
+	if len(stack.Layers) == 0 {
+		return LayoutNode{}
+	}
+
+	children := make([]LayoutNode, len(stack.Layers))
+	for i, layer := range stack.Layers {
+		children[i] = layoutNode(layer.Node, parentSize)
+	}
+
+	width := parentSize.Width
+	if width <= 0 {
+		width = children[0].outerWidth()
+	}
+	height := parentSize.Height
+	if height <= 0 {
+		height = children[0].outerHeight()
+	}
+
+	bounds := Rect{Width: width, Height: height}
+	for i, layer := range stack.Layers {
+		children[i].place(
+			anchorOffset(layer.HAlign, width, children[i].outerWidth())+layer.X,
+			anchorOffset(layer.VAlign, height, children[i].outerHeight())+layer.Y,
+		)
+		clip := bounds
+		children[i].clip = &clip
+	}
+
+	return LayoutNode{
+		Rect:     bounds,
+		Children: children,
+	}
+}
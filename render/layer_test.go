@@ -0,0 +1,130 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// layerView stacks layers over a 6x3 base of dots.
+func layerView(layers ...bubbleviews.Layer) bubbleviews.View {
+	base := bubbleviews.Layer{Node: bubbleviews.ASCIIArtNode{ID: "base", Lines: []string{"......", "......", "......"}}}
+	return bubbleviews.View{Children: []bubbleviews.Node{
+		bubbleviews.LayerNode{Layers: append([]bubbleviews.Layer{base}, layers...)},
+	}}
+}
+
+// popover wraps value in a plain box so the layer keeps its natural size.
+func popover(id, value string) bubbleviews.Node {
+	return bubbleviews.BoxNode{
+		ID:      id,
+		Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: value}}},
+	}
+}
+
+func TestLayersAnchorAndOffset(t *testing.T) {
+	tests := []struct {
+		name  string
+		layer bubbleviews.Layer
+		want  []string
+	}{
+		{name: "start", layer: bubbleviews.Layer{}, want: []string{"ab....", "......", "......"}},
+		{name: "end", layer: bubbleviews.Layer{HAlign: bubbleviews.AlignEnd, VAlign: bubbleviews.AlignEnd}, want: []string{"......", "......", "....ab"}},
+		{name: "center", layer: bubbleviews.Layer{HAlign: bubbleviews.AlignCenter, VAlign: bubbleviews.AlignCenter}, want: []string{"......", "..ab..", "......"}},
+		{name: "offset from start", layer: bubbleviews.Layer{X: 1, Y: 2}, want: []string{"......", "......", ".ab..."}},
+		{name: "offset from end", layer: bubbleviews.Layer{HAlign: bubbleviews.AlignEnd, VAlign: bubbleviews.AlignEnd, X: -1, Y: -1}, want: []string{"......", "...ab.", "......"}},
+		{name: "offset from center", layer: bubbleviews.Layer{HAlign: bubbleviews.AlignCenter, VAlign: bubbleviews.AlignCenter, X: -2, Y: 1}, want: []string{"......", "......", "ab...."}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.layer.Node = popover("", "ab")
+			got := Render(layerView(tt.layer))
+			if want := strings.Join(tt.want, "\n"); got != want {
+				t.Fatalf("expected\n%s\ngot\n%s", want, got)
+			}
+		})
+	}
+}
+
+func TestLayersClipToTheStack(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		layer bubbleviews.Layer
+		want  []string
+	}{
+		{name: "past the right edge", value: "abcd", layer: bubbleviews.Layer{X: 4}, want: []string{"....ab", "......", "......"}},
+		{name: "past the bottom edge", value: "abcd", layer: bubbleviews.Layer{VAlign: bubbleviews.AlignEnd, Y: 1}, want: []string{"......", "......", "......"}},
+		{name: "before the left edge", value: "abcd", layer: bubbleviews.Layer{X: -2, Y: 1}, want: []string{"......", "cd....", "......"}},
+		{name: "wider than the stack", value: "abcdefgh", layer: bubbleviews.Layer{X: 3, Y: 1}, want: []string{"......", "...abc", "......"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.layer.Node = popover("", tt.value)
+			got := Render(layerView(tt.layer))
+			if want := strings.Join(tt.want, "\n"); got != want {
+				t.Fatalf("expected\n%s\ngot\n%s", want, got)
+			}
+		})
+	}
+}
+
+func TestLaterLayersCoverEarlierCells(t *testing.T) {
+	modal := bubbleviews.BoxNode{
+		Style:   bubbleviews.BoxStyle{Background: "5", Padding: bubbleviews.Padding{Left: 1, Right: 1}},
+		Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: "a"}}},
+	}
+	view := bubbleviews.View{
+		Size: bubbleviews.Size{Width: 6, Height: 3},
+		Children: []bubbleviews.Node{bubbleviews.LayerNode{Layers: []bubbleviews.Layer{
+			{Node: bubbleviews.BoxNode{
+				Style:   bubbleviews.BoxStyle{Background: "4", FillWidth: true, FillHeight: true},
+				Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: "xxxxxx"}}},
+			}},
+			{Node: modal, HAlign: bubbleviews.AlignCenter, VAlign: bubbleviews.AlignStart},
+		}}},
+	}
+
+	surface := paintCells(view)
+	if got, want := Render(view), strings.Join([]string{"x a xx", "      ", "      "}, "\n"); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+	want := strings.Join([]string{"455544", "444444", "444444"}, "\n")
+	if got := backgroundMap(surface); got != want {
+		t.Fatalf("expected backgrounds\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestLayoutTreeAtPrefersTheTopLayer(t *testing.T) {
+	tree := Layout(layerView(bubbleviews.Layer{Node: popover("popover", "ab"), X: 2, Y: 1}))
+
+	tests := []struct {
+		x, y int
+		want string
+	}{
+		{x: 2, y: 1, want: "popover"},
+		{x: 3, y: 1, want: "popover"},
+		{x: 1, y: 1, want: "base"},
+		{x: 4, y: 1, want: "base"},
+		{x: 2, y: 0, want: "base"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d,%d", tt.x, tt.y), func(t *testing.T) {
+			path := tree.At(tt.x, tt.y)
+			var ids []string
+			for _, node := range path {
+				if id := bubbleviews.NodeID(node.Node); id != "" {
+					ids = append(ids, id)
+				}
+			}
+			if len(ids) != 1 || ids[0] != tt.want {
+				t.Fatalf("expected %s on top, got %v", tt.want, ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,722 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// Rect is a rectangle of terminal cells. Rects reported by Layout use absolute
+// coordinates with the origin at the top-left cell of the rendered output.
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// Contains reports whether the cell at x, y falls inside r.
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// Empty reports whether r covers no cells.
+func (r Rect) Empty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+func (r Rect) intersect(other Rect) Rect {
+	x0, y0 := max(r.X, other.X), max(r.Y, other.Y)
+	x1 := min(r.X+r.Width, other.X+other.Width)
+	y1 := min(r.Y+r.Height, other.Y+other.Height)
+	if x1 <= x0 || y1 <= y0 {
+		return Rect{X: x0, Y: y0}
+	}
+	return Rect{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
+
+func (r Rect) translate(dx, dy int) Rect {
+	r.X += dx
+	r.Y += dy
+	return r
+}
+
+// LayoutTree is the resolved geometry of a view: one LayoutNode per top-level
+// child, stacked vertically within Size.
+type LayoutTree struct {
+	Size  bubbleviews.Size
+	Nodes []LayoutNode
+
+	ellipsis *Rect // row replaced by an ellipsis after clipping, if any
+}
+
+// LayoutNode records where a node landed. Rect covers the node's full extent
+// including any border and padding, while Content is the area inside them;
+// the two are equal for nodes without a frame.
+type LayoutNode struct {
+	Node     bubbleviews.Node
+	Rect     Rect
+	Content  Rect
+	Children []LayoutNode
+
+	lines    []string            // resolved rows for text and ASCII art leaves
+	rich     []richLine          // resolved rows for rich text leaves
+	style    cellStyle           // resolved style for text and ASCII art leaves
+	aligned  bool                // whether lines align within Rect, set when the parent offered a width
+	clip     *Rect               // limits painting of this node and its children
+	ellipsis *Rect               // content row replaced by an ellipsis after clipping, if any
+	scroll   *scrollbar          // scrollbar painted beside a scroll node's viewport
+	divider  *Rect               // line painted between a split node's panes
+	margin   bubbleviews.Padding // space the parent reserves around Rect
+	joined   bool                // border merges with the lines it overlaps, set by collapsing containers
+}
+
+// outerWidth is the width a node occupies in its parent, margin included.
+func (n LayoutNode) outerWidth() int {
+	return n.Rect.Width + n.margin.Left + n.margin.Right
+}
+
+// outerHeight is the height a node occupies in its parent, margin included.
+func (n LayoutNode) outerHeight() int {
+	return n.Rect.Height + n.margin.Top + n.margin.Bottom
+}
+
+// place positions the node so its margin box starts at x, y.
+func (n *LayoutNode) place(x, y int) {
+	n.Rect.X = x + n.margin.Left
+	n.Rect.Y = y + n.margin.Top
+}
+
+// Layout resolves the size and position of every node in view without
+// producing any output. Render paints from the same tree. When the view
+// clips its overflow, Size never exceeds the view's requested size.
+func Layout(view bubbleviews.View) LayoutTree {
+	nodes, size := layoutView(view)
+	for i := range nodes {
+		absolutize(&nodes[i], 0, 0)
+	}
+
+	tree := LayoutTree{Size: size, Nodes: nodes}
+	if clipsOverflow(view.Overflow) {
+		if view.Size.Width > 0 {
+			tree.Size.Width = min(size.Width, view.Size.Width)
+		}
+		if view.Size.Height > 0 {
+			tree.Size.Height = min(size.Height, view.Size.Height)
+		}
+		area := Rect{Width: tree.Size.Width, Height: tree.Size.Height}
+		tree.ellipsis = ellipsisRow(view.Overflow, area, size.Height)
+	}
+	return tree
+}
+
+// absolutize converts a subtree laid out relative to its parent's origin into
+// absolute coordinates.
+func absolutize(node *LayoutNode, originX, originY int) {
+	node.Rect = node.Rect.translate(originX, originY)
+	node.Content = node.Content.translate(node.Rect.X, node.Rect.Y)
+	if node.clip != nil {
+		clip := node.clip.translate(originX, originY)
+		node.clip = &clip
+	}
+	if node.ellipsis != nil {
+		row := node.ellipsis.translate(node.Rect.X, node.Rect.Y)
+		node.ellipsis = &row
+	}
+	if node.divider != nil {
+		divider := node.divider.translate(node.Rect.X, node.Rect.Y)
+		node.divider = &divider
+	}
+	if node.scroll != nil {
+		bar := scrollbar{
+			track: node.scroll.track.translate(node.Rect.X, node.Rect.Y),
+			thumb: node.scroll.thumb.translate(node.Rect.X, node.Rect.Y),
+		}
+		node.scroll = &bar
+	}
+	for i := range node.Children {
+		absolutize(&node.Children[i], node.Rect.X, node.Rect.Y)
+	}
+}
+
+// layoutView stacks a view's children vertically. Children that occupy no
+// cells are dropped.
+func layoutView(view bubbleviews.View) ([]LayoutNode, bubbleviews.Size) {
+	children := make([]LayoutNode, 0, len(view.Children))
+	size := bubbleviews.Size{}
+
+	for _, child := range view.Children {
+		laid := layoutNode(child, view.Size)
+		if laid.Rect.Empty() {
+			continue
+		}
+		laid.place(0, size.Height)
+		size.Height += laid.outerHeight()
+		size.Width = max(size.Width, laid.outerWidth())
+		children = append(children, laid)
+	}
+
+	return children, size
+}
+
+// layoutNode resolves node against the space its parent offers. Any margin is
+// taken out of that space first. The returned rect sits just inside the
+// margin at the origin; callers position it with place.
+func layoutNode(node bubbleviews.Node, parentSize bubbleviews.Size) LayoutNode {
+	value := unwrapNode(node)
+	margin := nodeMargin(value)
+	parentSize = insetSize(parentSize, margin)
+
+	var laid LayoutNode
+	switch n := value.(type) {
+	case bubbleviews.BoxNode:
+		laid = layoutBox(n, parentSize)
+	case bubbleviews.MarginNode:
+		laid = layoutMargin(n, parentSize)
+	case bubbleviews.ScrollNode:
+		laid = layoutScroll(n, parentSize)
+	case bubbleviews.SplitNode:
+		laid = layoutSplit(n, parentSize)
+	case bubbleviews.VirtualListNode:
+		laid = layoutVirtualList(n, parentSize)
+	case bubbleviews.FlexNode:
+		laid = layoutFlex(n, parentSize)
+	case bubbleviews.FlowNode:
+		laid = layoutFlow(n, parentSize)
+	case bubbleviews.GridNode:
+		laid = layoutGrid(n, parentSize)
+	case bubbleviews.LayerNode:
+		laid = layoutLayers(n, parentSize)
+	case bubbleviews.ASCIIArtNode:
+		laid = layoutASCIIArt(n, parentSize)
+	case bubbleviews.TextNode:
+		laid = layoutText(n, parentSize)
+	case bubbleviews.RichTextNode:
+		laid = layoutRichText(n, parentSize)
+	case bubbleviews.MarkdownNode:
+		laid = layoutMarkdown(n, parentSize)
+	}
+
+	laid.Node = node
+	if laid.Content == (Rect{}) {
+		laid.Content = Rect{Width: laid.Rect.Width, Height: laid.Rect.Height}
+	}
+	laid.margin = margin
+	laid.place(0, 0)
+	return laid
+}
+
+// nodeMargin returns the margin a node asks its parent to keep around it.
+func nodeMargin(node bubbleviews.Node) bubbleviews.Padding {
+	var margin bubbleviews.Padding
+	switch n := node.(type) {
+	case bubbleviews.BoxNode:
+		margin = n.Style.Margin
+	case bubbleviews.MarginNode:
+		margin = n.Margin
+	}
+	margin.Top = max(margin.Top, 0)
+	margin.Right = max(margin.Right, 0)
+	margin.Bottom = max(margin.Bottom, 0)
+	margin.Left = max(margin.Left, 0)
+	return margin
+}
+
+// insetSize shrinks the space a parent offers by margin. Unconstrained axes
+// stay unconstrained.
+func insetSize(size bubbleviews.Size, margin bubbleviews.Padding) bubbleviews.Size {
+	if size.Width > 0 {
+		size.Width = max(size.Width-margin.Left-margin.Right, 1)
+	}
+	if size.Height > 0 {
+		size.Height = max(size.Height-margin.Top-margin.Bottom, 1)
+	}
+	return size
+}
+
+// unwrapNode dereferences pointer nodes so layout and painting only deal with
+// values.
+func unwrapNode(node bubbleviews.Node) bubbleviews.Node {
+	switch n := node.(type) {
+	case *bubbleviews.BoxNode:
+		return *n
+	case *bubbleviews.MarginNode:
+		return *n
+	case *bubbleviews.ScrollNode:
+		return *n
+	case *bubbleviews.SplitNode:
+		return *n
+	case *bubbleviews.VirtualListNode:
+		return *n
+	case *bubbleviews.FlexNode:
+		return *n
+	case *bubbleviews.FlowNode:
+		return *n
+	case *bubbleviews.GridNode:
+		return *n
+	case *bubbleviews.LayerNode:
+		return *n
+	case *bubbleviews.ASCIIArtNode:
+		return *n
+	case *bubbleviews.TextNode:
+		return *n
+	case *bubbleviews.RichTextNode:
+		return *n
+	case *bubbleviews.MarkdownNode:
+		return *n
+	default:
+		return node
+	}
+}
+
+func layoutBox(box bubbleviews.BoxNode, parentSize bubbleviews.Size) LayoutNode {
+	border := borderInsets(box.Style)
+	padding := box.Style.Padding
+	frameWidth := padding.Left + padding.Right + border.Left + border.Right
+	frameHeight := padding.Top + padding.Bottom + border.Top + border.Bottom
+
+	outerWidth := resolveBoxLength(box.Style.Width, box.Style.FillWidth, parentSize.Width)
+	outerHeight := resolveBoxLength(box.Style.Height, box.Style.FillHeight, parentSize.Height)
+
+	contentWidth := box.Content.Size.Width
+	if outerWidth > 0 {
+		contentWidth = outerWidth - frameWidth
+	}
+	contentWidth = max(contentWidth, 0)
+
+	contentHeight := box.Content.Size.Height
+	if outerHeight > 0 {
+		contentHeight = outerHeight - frameHeight
+	}
+	contentHeight = max(contentHeight, 0)
+
+	contentView := box.Content
+	contentView.Size = bubbleviews.Size{Width: contentWidth, Height: contentHeight}
+	children, natural := layoutView(contentView)
+
+	areaWidth := contentWidth
+	if areaWidth == 0 {
+		areaWidth = natural.Width
+	}
+	areaHeight := max(contentHeight, natural.Height)
+	if clipsOverflow(box.Style.Overflow) && (outerHeight > 0 || box.Content.Size.Height > 0) {
+		areaHeight = contentHeight
+	}
+
+	content := Rect{
+		X:      border.Left + padding.Left,
+		Y:      border.Top + padding.Top,
+		Width:  areaWidth,
+		Height: areaHeight,
+	}
+
+	offsetX := content.X + max(anchorOffset(box.Style.HAlign, areaWidth, natural.Width), 0)
+	offsetY := content.Y + max(anchorOffset(box.Style.VAlign, areaHeight, natural.Height), 0)
+	for i := range children {
+		children[i].Rect = children[i].Rect.translate(offsetX, offsetY)
+		clip := content
+		children[i].clip = &clip
+	}
+
+	return LayoutNode{
+		Rect:     Rect{Width: areaWidth + frameWidth, Height: areaHeight + frameHeight},
+		Content:  content,
+		Children: children,
+		ellipsis: ellipsisRow(box.Style.Overflow, content, natural.Height),
+	}
+}
+
+func clipsOverflow(overflow bubbleviews.Overflow) bool {
+	return overflow == bubbleviews.OverflowClip || overflow == bubbleviews.OverflowEllipsisRow
+}
+
+// ellipsisRow returns the last row of area when overflow asks for an
+// ellipsis and content of the given height was cut off to fit it.
+func ellipsisRow(overflow bubbleviews.Overflow, area Rect, height int) *Rect {
+	if overflow != bubbleviews.OverflowEllipsisRow || area.Empty() || height <= area.Height {
+		return nil
+	}
+	return &Rect{X: area.X, Y: area.Y + area.Height - 1, Width: area.Width, Height: 1}
+}
+
+// layoutMargin wraps a node whose margin layoutNode has already reserved, so
+// the wrapper simply covers its child.
+func layoutMargin(wrapper bubbleviews.MarginNode, parentSize bubbleviews.Size) LayoutNode {
+	if wrapper.Node == nil {
+		return LayoutNode{}
+	}
+
+	child := layoutNode(wrapper.Node, parentSize)
+	return LayoutNode{
+		Rect:     Rect{Width: child.outerWidth(), Height: child.outerHeight()},
+		Children: []LayoutNode{child},
+	}
+}
+
+// layoutMarkdown lays out the nodes a markdown document expands into, so its
+// blocks wrap at the width the parent offers.
+func layoutMarkdown(doc bubbleviews.MarkdownNode, parentSize bubbleviews.Size) LayoutNode {
+	child := layoutNode(doc.Node(), parentSize)
+	return LayoutNode{
+		Rect:     Rect{Width: child.outerWidth(), Height: child.outerHeight()},
+		Children: []LayoutNode{child},
+	}
+}
+
+// resolveBoxLength returns the outer length a box should occupy along one
+// axis, or zero when it should size to its content.
+func resolveBoxLength(length bubbleviews.Dimension, fill bool, parent int) int {
+	switch length.Unit {
+	case bubbleviews.UnitCells:
+		return max(length.Value, 0)
+	case bubbleviews.UnitPercent:
+		if parent > 0 {
+			return resolvePercents([]int{length.Value}, parent)[0]
+		}
+		return 0
+	case bubbleviews.UnitFraction:
+		return max(parent, 0)
+	}
+
+	if fill && parent > 0 {
+		return parent
+	}
+	return 0
+}
+
+func layoutFlex(flex bubbleviews.FlexNode, parentSize bubbleviews.Size) LayoutNode {
+	if len(flex.Items) == 0 {
+		return LayoutNode{}
+	}
+
+	if flex.CollapseBorders {
+		// Neighbours overlap by a cell so their borders share one line.
+		flex.Spacing = -1
+	}
+
+	var laid LayoutNode
+	switch flex.Direction {
+	case bubbleviews.FlexDirectionColumn:
+		laid = layoutFlexColumn(flex, parentSize)
+	default:
+		laid = layoutFlexRow(flex, parentSize)
+	}
+	if flex.CollapseBorders {
+		joinBorders(laid.Children)
+	}
+	return laid
+}
+
+// joinBorders marks nodes laid out by a collapsing container so their borders
+// merge into junctions where they overlap.
+func joinBorders(children []LayoutNode) {
+	for i := range children {
+		children[i].joined = true
+	}
+}
+
+func layoutFlexRow(flex bubbleviews.FlexNode, parentSize bubbleviews.Size) LayoutNode {
+	widths := computeFlexWidths(flex, parentSize.Width)
+	children := make([]LayoutNode, len(flex.Items))
+	lineHeight := 0
+
+	for i, item := range flex.Items {
+		childSize := bubbleviews.Size{
+			Width:  widths[i],
+			Height: parentSize.Height,
+		}
+		children[i] = layoutNode(item.Node, childSize)
+		lineHeight = max(lineHeight, children[i].outerHeight())
+	}
+
+	slots := make([]int, len(flex.Items))
+	offsets := make([]int, len(flex.Items))
+	usedWidth := 0
+	for i, item := range flex.Items {
+		align := itemAlign(flex, item)
+		if align == bubbleviews.FlexAlignStretch && children[i].outerHeight() < lineHeight {
+			childSize := bubbleviews.Size{Width: widths[i], Height: lineHeight}
+			children[i] = layoutNode(stretchNode(item.Node, flex.Direction), childSize)
+			children[i].Node = item.Node
+		}
+
+		slots[i] = max(widths[i], children[i].outerWidth())
+		offsets[i] = max(crossOffset(align, lineHeight, children[i].outerHeight()), 0)
+		usedWidth += slots[i]
+	}
+
+	free := 0
+	if parentSize.Width > 0 {
+		free = parentSize.Width - usedWidth - flex.Spacing*(len(flex.Items)-1)
+	}
+	lead, between := justifyGaps(flex.Justify, free, len(flex.Items))
+
+	x := lead
+	for i := range children {
+		if i > 0 {
+			x += flex.Spacing + between[i-1]
+		}
+		children[i].place(x, offsets[i])
+		x += slots[i]
+	}
+
+	return LayoutNode{
+		Rect:     Rect{Width: x, Height: lineHeight},
+		Children: children,
+	}
+}
+
+func layoutFlexColumn(flex bubbleviews.FlexNode, parentSize bubbleviews.Size) LayoutNode {
+	heights, measured := computeFlexHeights(flex, parentSize)
+	children := make([]LayoutNode, len(flex.Items))
+	crossWidth := parentSize.Width
+
+	for i, item := range flex.Items {
+		if measured[i] != nil && measured[i].outerHeight() == heights[i] {
+			children[i] = *measured[i]
+		} else {
+			childSize := bubbleviews.Size{
+				Width:  parentSize.Width,
+				Height: heights[i],
+			}
+			children[i] = layoutNode(item.Node, childSize)
+		}
+
+		if parentSize.Width <= 0 {
+			crossWidth = max(crossWidth, children[i].outerWidth())
+		}
+	}
+
+	slots := make([]int, len(flex.Items))
+	offsets := make([]int, len(flex.Items))
+	usedHeight := 0
+	width := crossWidth
+	for i, item := range flex.Items {
+		align := itemAlign(flex, item)
+		if align == bubbleviews.FlexAlignStretch && children[i].outerWidth() < crossWidth {
+			childSize := bubbleviews.Size{Width: crossWidth, Height: heights[i]}
+			children[i] = layoutNode(stretchNode(item.Node, flex.Direction), childSize)
+			children[i].Node = item.Node
+		}
+
+		slots[i] = max(heights[i], children[i].outerHeight())
+		offsets[i] = max(crossOffset(align, crossWidth, children[i].outerWidth()), 0)
+		width = max(width, children[i].outerWidth())
+		usedHeight += slots[i]
+	}
+
+	free := 0
+	if parentSize.Height > 0 {
+		free = parentSize.Height - usedHeight - flex.Spacing*(len(flex.Items)-1)
+	}
+	lead, between := justifyGaps(flex.Justify, free, len(flex.Items))
+
+	y := lead
+	for i := range children {
+		if i > 0 {
+			y += flex.Spacing + between[i-1]
+		}
+		children[i].place(offsets[i], y)
+		y += slots[i]
+	}
+
+	return LayoutNode{
+		Rect:     Rect{Width: width, Height: y},
+		Children: children,
+	}
+}
+
+func layoutFlow(flow bubbleviews.FlowNode, parentSize bubbleviews.Size) LayoutNode {
+	if len(flow.Items) == 0 {
+		return LayoutNode{}
+	}
+
+	itemSpacing := max(flow.ItemSpacing, 0)
+	rowSpacing := max(flow.RowSpacing, 0)
+	minWidth := max(flow.ItemMinWidth, 1)
+
+	maxColumns := len(flow.Items)
+	if parentSize.Width > 0 {
+		columns := max((parentSize.Width+itemSpacing)/(minWidth+itemSpacing), 1)
+		maxColumns = min(maxColumns, columns)
+	}
+
+	columnWidth := 0
+	if parentSize.Width > 0 {
+		columnWidth = max(parentSize.Width-itemSpacing*(maxColumns-1), 0) / maxColumns
+	}
+
+	children := make([]LayoutNode, len(flow.Items))
+	width, y := 0, 0
+
+	for start := 0; start < len(flow.Items); start += maxColumns {
+		end := min(start+maxColumns, len(flow.Items))
+		if start > 0 {
+			y += rowSpacing
+		}
+
+		x, rowHeight := 0, 0
+		for i := start; i < end; i++ {
+			if i > start {
+				x += itemSpacing
+			}
+			size := bubbleviews.Size{Width: columnWidth, Height: parentSize.Height}
+			children[i] = layoutNode(flow.Items[i], size)
+			children[i].place(x, y)
+			x += children[i].outerWidth()
+			rowHeight = max(rowHeight, children[i].outerHeight())
+		}
+
+		width = max(width, x)
+		y += rowHeight
+	}
+
+	return LayoutNode{
+		Rect:     Rect{Width: width, Height: y},
+		Children: children,
+	}
+}
+
+func layoutASCIIArt(art bubbleviews.ASCIIArtNode, parentSize bubbleviews.Size) LayoutNode {
+	if len(art.Lines) == 0 {
+		return LayoutNode{}
+	}
+
+	lines := make([]string, 0, len(art.Lines))
+	for _, line := range art.Lines {
+		lines = append(lines, splitLines(line)...)
+	}
+
+	return LayoutNode{
+		Rect:    Rect{Width: max(parentSize.Width, linesWidth(lines)), Height: len(lines)},
+		lines:   lines,
+		style:   leafStyle(art.Style, art.Color, art.Bold),
+		aligned: parentSize.Width > 0,
+	}
+}
+
+func layoutText(text bubbleviews.TextNode, parentSize bubbleviews.Size) LayoutNode {
+	width := parentSize.Width
+	prefix := text.Prefix
+	continuation := text.ContinuationPrefix
+	if continuation == "" {
+		continuation = strings.Repeat(" ", lipgloss.Width(prefix))
+	}
+
+	wrapWidth := width
+	if width > 0 {
+		prefixWidth := lipgloss.Width(prefix)
+		wrapWidth = max(width-prefixWidth, 1)
+	}
+
+	var segments []string
+	if text.Wrap && wrapWidth > 0 {
+		segments = wrapText(text.Value, wrapWidth, text.WrapMode, text.Hyphenate)
+	} else {
+		segments = []string{text.Value}
+	}
+
+	lines := make([]string, 0, len(segments))
+	for i, segment := range segments {
+		linePrefix := prefix
+		if i > 0 {
+			linePrefix = continuation
+		}
+
+		content := linePrefix + segment
+		if text.Truncate && width > 0 {
+			content = truncateString(content, width, text.TruncateSuffix)
+		}
+
+		for _, line := range splitLines(content) {
+			// Lines that still exceed the offered width break rather than
+			// spill past their parent's frame.
+			if width > 0 && lipgloss.Width(line) > width {
+				lines = append(lines, strings.Split(ansi.Wrap(line, width, ""), "\n")...)
+				continue
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	return LayoutNode{
+		Rect:    Rect{Width: max(width, linesWidth(lines)), Height: len(lines)},
+		lines:   lines,
+		style:   leafStyle(text.Style, text.Color, text.Bold),
+		aligned: width > 0,
+	}
+}
+
+// leafStyle folds the deprecated Color and Bold fields of text and ASCII art
+// nodes into their Style.
+func leafStyle(style bubbleviews.TextStyle, color bubbleviews.Color, bold bool) cellStyle {
+	return textCellStyle(style.Inherit(bubbleviews.TextStyle{Color: color, Bold: bold}))
+}
+
+// splitLines breaks s on newlines and expands tabs the way Lip Gloss does.
+func splitLines(s string) []string {
+	return strings.Split(strings.ReplaceAll(s, "\t", "    "), "\n")
+}
+
+func linesWidth(lines []string) int {
+	width := 0
+	for _, line := range lines {
+		width = max(width, lipgloss.Width(line))
+	}
+	return width
+}
+
+// anchorOffset returns where a span of length size starts when aligned within
+// a span of length total.
+func anchorOffset(align bubbleviews.Alignment, total, size int) int {
+	switch align {
+	case bubbleviews.AlignCenter:
+		return (total - size) / 2
+	case bubbleviews.AlignEnd:
+		return total - size
+	default:
+		return 0
+	}
+}
+
+// crossOffset is anchorOffset for flex cross-axis alignment.
+func crossOffset(align bubbleviews.FlexAlign, total, size int) int {
+	switch align {
+	case bubbleviews.FlexAlignCenter:
+		return (total - size) / 2
+	case bubbleviews.FlexAlignEnd:
+		return total - size
+	default:
+		return 0
+	}
+}
+
+// borderInsets reports how many cells the border takes on each side of a box.
+func borderInsets(style bubbleviews.BoxStyle) bubbleviews.Padding {
+	if mapBorderStyle(style) == nil {
+		return bubbleviews.Padding{}
+	}
+	sides := borderSides(style)
+	return bubbleviews.Padding{
+		Top:    boolCells(sides.Top),
+		Right:  boolCells(sides.Right),
+		Bottom: boolCells(sides.Bottom),
+		Left:   boolCells(sides.Left),
+	}
+}
+
+// borderSides resolves the edges a box draws, expanding the zero value to
+// the full frame.
+func borderSides(style bubbleviews.BoxStyle) bubbleviews.BorderSides {
+	if style.BorderSides == (bubbleviews.BorderSides{}) {
+		return bubbleviews.BorderSides{Top: true, Right: true, Bottom: true, Left: true}
+	}
+	return style.BorderSides
+}
+
+func boolCells(on bool) int {
+	if on {
+		return 1
+	}
+	return 0
+}
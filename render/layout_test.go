@@ -0,0 +1,156 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func TestLayoutBoxSeparatesFrameFromContent(t *testing.T) {
+	laid := Layout(bubbleviews.View{
+		Size: bubbleviews.Size{Width: 20, Height: 6},
+		Children: []bubbleviews.Node{bubbleviews.BoxNode{
+			ID: "card",
+			Style: bubbleviews.BoxStyle{
+				Border:    bubbleviews.BorderThin,
+				Padding:   bubbleviews.Padding{Top: 1, Left: 2, Right: 2},
+				FillWidth: true,
+			},
+			Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: "cam"}}},
+		}},
+	})
+
+	card, ok := laid.Find("card")
+	if !ok {
+		t.Fatal("expected to find the card")
+	}
+	if want := (Rect{X: 0, Y: 0, Width: 20, Height: 4}); card.Rect != want {
+		t.Fatalf("expected rect %v, got %v", want, card.Rect)
+	}
+	if want := (Rect{X: 3, Y: 2, Width: 14, Height: 1}); card.Content != want {
+		t.Fatalf("expected content %v, got %v", want, card.Content)
+	}
+	if want := (Rect{X: 3, Y: 2, Width: 14, Height: 1}); card.Children[0].Rect != want {
+		t.Fatalf("expected text rect %v, got %v", want, card.Children[0].Rect)
+	}
+}
+
+func TestLayoutStacksTopLevelNodes(t *testing.T) {
+	laid := Layout(bubbleviews.View{
+		Children: []bubbleviews.Node{
+			bubbleviews.TextNode{Value: "one"},
+			bubbleviews.TextNode{Value: "two\nlines"},
+			bubbleviews.TextNode{Value: "three"},
+		},
+	})
+
+	var got []Rect
+	for _, node := range laid.Nodes {
+		got = append(got, node.Rect)
+	}
+	want := []Rect{{X: 0, Y: 0, Width: 3, Height: 1}, {X: 0, Y: 1, Width: 5, Height: 2}, {X: 0, Y: 3, Width: 5, Height: 1}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected rects %v, got %v", want, got)
+	}
+	if want := (bubbleviews.Size{Width: 5, Height: 4}); laid.Size != want {
+		t.Fatalf("expected size %v, got %v", want, laid.Size)
+	}
+}
+
+func TestLayoutBreaksTextWiderThanItsBox(t *testing.T) {
+	view := boxView(20, bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, FillWidth: true},
+		bubbleviews.TextNode{Value: "a long line of text that exceeds the box width"})
+
+	want := strings.Join([]string{
+		"┌──────────────────┐",
+		"│a long line of    │",
+		"│text that exceeds │",
+		"│the box width     │",
+		"└──────────────────┘",
+	}, "\n")
+	if got := Render(view); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestPaintDrawsTheLaidOutTree(t *testing.T) {
+	view := bubbleviews.View{
+		Size: bubbleviews.Size{Width: 30, Height: 8},
+		Children: []bubbleviews.Node{
+			bubbleviews.TextNode{Value: "Cameras", Style: bubbleviews.TextStyle{Bold: true}},
+			bubbleviews.FlexNode{
+				Spacing: 1,
+				Items: []bubbleviews.FlexItem{
+					{Node: bubbleviews.BoxNode{
+						Style:   bubbleviews.BoxStyle{Border: bubbleviews.BorderRounded, Title: "Dock", FillWidth: true},
+						Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: "online"}}},
+					}, Grow: 1},
+					{Node: bubbleviews.TextNode{Value: "lobby camera offline", Wrap: true}, Grow: 1},
+				},
+			},
+		},
+	}
+
+	want := strings.Join([]string{
+		"Cameras                       ",
+		"╭─ Dock ──────╮ lobby camera  ",
+		"│online       │ offline       ",
+		"╰─────────────╯               ",
+	}, "\n")
+	painted := Paint(Layout(view))
+	if painted != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, painted)
+	}
+	if rendered := Render(view); rendered != painted {
+		t.Fatalf("expected Render to match Paint, got\n%s", rendered)
+	}
+}
+
+func TestMarginOffsetsAndShrinksItsNode(t *testing.T) {
+	laid := Layout(bubbleviews.View{
+		Size: bubbleviews.Size{Width: 20, Height: 10},
+		Children: []bubbleviews.Node{
+			bubbleviews.TextNode{Value: "above"},
+			bubbleviews.MarginNode{
+				Margin: bubbleviews.Padding{Top: 1, Bottom: 2, Left: 2, Right: 3},
+				Node: bubbleviews.BoxNode{
+					Style:   bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, FillWidth: true},
+					Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: "cam"}}},
+				},
+			},
+			bubbleviews.TextNode{Value: "below"},
+		},
+	})
+
+	box := laid.Nodes[1].Children[0]
+	if want := (Rect{X: 2, Y: 2, Width: 15, Height: 3}); box.Rect != want {
+		t.Fatalf("expected the box inside the margin at %v, got %v", want, box.Rect)
+	}
+	if want := (Rect{X: 3, Y: 3, Width: 13, Height: 1}); box.Content != want {
+		t.Fatalf("expected the box content at %v, got %v", want, box.Content)
+	}
+	if y := laid.Nodes[2].Rect.Y; y != 7 {
+		t.Fatalf("expected the next node below the bottom margin at row 7, got %d", y)
+	}
+}
+
+func TestMarginCountsTowardFlexItemSize(t *testing.T) {
+	card := bubbleviews.BoxNode{Style: bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, FillWidth: true}}
+	laid := Layout(bubbleviews.View{
+		Size: bubbleviews.Size{Width: 20, Height: 3},
+		Children: []bubbleviews.Node{bubbleviews.FlexNode{
+			Items: []bubbleviews.FlexItem{
+				{Node: bubbleviews.MarginNode{Margin: bubbleviews.Padding{Left: 2, Right: 2}, Node: card}, Grow: 1},
+				{Node: card, Grow: 1},
+			},
+		}},
+	})
+
+	row := laid.Nodes[0].Children
+	got := []int{row[0].Children[0].Rect.X, row[0].Children[0].Rect.Width, row[1].Rect.X, row[1].Rect.Width}
+	if want := []int{2, 6, 10, 10}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected x/width pairs %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,88 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func renderMarkdown(width int, source string) []string {
+	out := Render(bubbleviews.View{
+		Size:     bubbleviews.Size{Width: width},
+		Children: []bubbleviews.Node{bubbleviews.MarkdownNode{Source: source}},
+	})
+	lines := strings.Split(ansi.Strip(out), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return lines
+}
+
+func TestMarkdownWrapsListItemsUnderTheirText(t *testing.T) {
+	got := renderMarkdown(20, "Intro text\n\n- a list item long enough to wrap\n  - nested\n\n3. three\n4. four")
+	want := []string{
+		"Intro text",
+		"",
+		"• a list item long",
+		"  enough to wrap",
+		"  ◦ nested",
+		"",
+		"3. three",
+		"4. four",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestMarkdownDrawsQuotesRulesAndCode(t *testing.T) {
+	got := renderMarkdown(16, "> quoted\nlazily\n\n---\n\n```\n  indented code\n```")
+	want := []string{
+		"┃ quoted lazily",
+		"",
+		"────────────────",
+		"",
+		"   indented cod",
+		" e",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestMarkdownTableSharesBordersAndAligns(t *testing.T) {
+	got := renderMarkdown(30, "| Key | Action |\n| :-- | --: |\n| q | Quit |")
+	want := []string{
+		"┌─────┬────────┐",
+		"│ Key │ Action │",
+		"├─────┼────────┤",
+		"│ q   │   Quit │",
+		"└─────┴────────┘",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestMarkdownInlineStyles(t *testing.T) {
+	node := bubbleviews.MarkdownNode{Source: "**bold** _it_ `x` [docs](https://go.dev) snake_case 2 * 3"}.Node()
+	text, ok := node.(bubbleviews.RichTextNode)
+	if !ok {
+		t.Fatalf("expected a single paragraph, got %T", node)
+	}
+
+	var plain strings.Builder
+	styles := map[string]bubbleviews.TextStyle{}
+	for _, span := range text.Spans {
+		plain.WriteString(span.Text)
+		styles[span.Text] = span.Style
+	}
+	if want := "bold it x docs (https://go.dev) snake_case 2 * 3"; plain.String() != want {
+		t.Fatalf("expected %q, got %q", want, plain.String())
+	}
+	if !styles["bold"].Bold || !styles["it"].Italic || styles["x"].Background == "" || !styles["docs"].Underline || !styles[" (https://go.dev)"].Faint {
+		t.Fatalf("expected each span styled, got %+v", text.Spans)
+	}
+}
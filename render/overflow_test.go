@@ -0,0 +1,155 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// overflowRows returns five numbered text rows, more than the three the tests
+// leave room for.
+func overflowRows() []bubbleviews.Node {
+	rows := make([]bubbleviews.Node, 5)
+	for i := range rows {
+		rows[i] = bubbleviews.TextNode{Value: fmt.Sprintf("row %d", i+1)}
+	}
+	return rows
+}
+
+// wideBox is a bordered box far wider than the 20 cells the tests offer.
+func wideBox() bubbleviews.Node {
+	return bubbleviews.BoxNode{
+		Style:   bubbleviews.BoxStyle{Border: bubbleviews.BorderThin, Width: bubbleviews.Cells(50)},
+		Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: "wide"}}},
+	}
+}
+
+// checkLines fails unless out has want lines, each exactly width cells wide.
+func checkLines(t *testing.T, out string, want, width int) []string {
+	t.Helper()
+	lines := strings.Split(out, "\n")
+	if len(lines) != want {
+		t.Fatalf("expected %d lines, got %d:\n%s", want, len(lines), out)
+	}
+	for i, line := range lines {
+		if got := lipgloss.Width(line); got != width {
+			t.Fatalf("line %d: expected width %d, got %d:\n%s", i, width, got, out)
+		}
+	}
+	return lines
+}
+
+func TestViewOverflow(t *testing.T) {
+	tests := []struct {
+		overflow bubbleviews.Overflow
+		want     []string
+	}{
+		{overflow: bubbleviews.OverflowVisible, want: []string{"row 1", "row 2", "row 3", "row 4", "row 5"}},
+		{overflow: bubbleviews.OverflowClip, want: []string{"row 1", "row 2", "row 3"}},
+		{overflow: bubbleviews.OverflowEllipsisRow, want: []string{"row 1", "row 2", "…"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.overflow), func(t *testing.T) {
+			out := Render(bubbleviews.View{
+				Size:     bubbleviews.Size{Width: 20, Height: 3},
+				Overflow: tt.overflow,
+				Children: overflowRows(),
+			})
+			lines := checkLines(t, out, len(tt.want), 20)
+			for i, want := range tt.want {
+				if got := strings.TrimRight(lines[i], " "); got != want {
+					t.Fatalf("line %d: expected %q, got %q", i, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestViewOverflowClipsWideChildren(t *testing.T) {
+	for _, overflow := range []bubbleviews.Overflow{bubbleviews.OverflowClip, bubbleviews.OverflowEllipsisRow} {
+		t.Run(string(overflow), func(t *testing.T) {
+			out := Render(bubbleviews.View{
+				Size:     bubbleviews.Size{Width: 20, Height: 2},
+				Overflow: overflow,
+				Children: []bubbleviews.Node{wideBox()},
+			})
+			want := []string{"┌───────────────────", "│wide               "}
+			if overflow == bubbleviews.OverflowEllipsisRow {
+				want[1] = "…                   "
+			}
+			if lines := checkLines(t, out, 2, 20); fmt.Sprint(lines) != fmt.Sprint(want) {
+				t.Fatalf("expected %q, got %q", want, lines)
+			}
+		})
+	}
+}
+
+func TestBoxOverflow(t *testing.T) {
+	tests := []struct {
+		overflow bubbleviews.Overflow
+		want     []string
+	}{
+		{
+			overflow: bubbleviews.OverflowVisible,
+			want:     []string{"┌──────────────────┐", "│row 1             │", "│row 2             │", "│row 3             │", "│row 4             │", "│row 5             │", "└──────────────────┘"},
+		},
+		{
+			overflow: bubbleviews.OverflowClip,
+			want:     []string{"┌──────────────────┐", "│row 1             │", "│row 2             │", "│row 3             │", "└──────────────────┘"},
+		},
+		{
+			overflow: bubbleviews.OverflowEllipsisRow,
+			want:     []string{"┌──────────────────┐", "│row 1             │", "│row 2             │", "│…                 │", "└──────────────────┘"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.overflow), func(t *testing.T) {
+			style := bubbleviews.BoxStyle{
+				Border:    bubbleviews.BorderThin,
+				FillWidth: true,
+				Height:    bubbleviews.Cells(5),
+				Overflow:  tt.overflow,
+			}
+			out := Render(boxView(20, style, overflowRows()...))
+			if lines := checkLines(t, out, len(tt.want), 20); fmt.Sprint(lines) != fmt.Sprint(tt.want) {
+				t.Fatalf("expected\n%s\ngot\n%s", strings.Join(tt.want, "\n"), out)
+			}
+		})
+	}
+}
+
+func TestBoxOverflowClipsWideChildren(t *testing.T) {
+	tests := []struct {
+		overflow bubbleviews.Overflow
+		want     []string
+	}{
+		{
+			overflow: bubbleviews.OverflowClip,
+			want:     []string{"┌──────────────────┐", "│┌─────────────────│", "││wide             │", "└──────────────────┘"},
+		},
+		{
+			overflow: bubbleviews.OverflowEllipsisRow,
+			want:     []string{"┌──────────────────┐", "│┌─────────────────│", "│…                 │", "└──────────────────┘"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.overflow), func(t *testing.T) {
+			style := bubbleviews.BoxStyle{
+				Border:    bubbleviews.BorderThin,
+				FillWidth: true,
+				Height:    bubbleviews.Cells(4),
+				Overflow:  tt.overflow,
+			}
+			out := Render(boxView(20, style, wideBox()))
+			if lines := checkLines(t, out, len(tt.want), 20); fmt.Sprint(lines) != fmt.Sprint(tt.want) {
+				t.Fatalf("expected\n%s\ngot\n%s", strings.Join(tt.want, "\n"), out)
+			}
+		})
+	}
+}
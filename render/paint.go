@@ -0,0 +1,280 @@
+package render
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// Paint draws a tree returned by Layout. Render(view) is equivalent to
+// Paint(Layout(view)); call the two separately to keep the geometry around,
+// for example to hit-test mouse events against the frame on screen.
+func Paint(tree LayoutTree) string {
+	if len(tree.Nodes) == 0 {
+		return ""
+	}
+
+	surface := newCanvas(tree.Size.Width, tree.Size.Height)
+	for i := range tree.Nodes {
+		paintNode(surface, &tree.Nodes[i], surface.bounds())
+	}
+	paintEllipsis(surface, tree.ellipsis, surface.bounds())
+	return surface.String()
+}
+
+func paintNode(surface *canvas, laid *LayoutNode, clip Rect) {
+	if laid.clip != nil {
+		clip = clip.intersect(*laid.clip)
+	}
+	if clip.Empty() {
+		return
+	}
+
+	switch n := unwrapNode(laid.Node).(type) {
+	case bubbleviews.BoxNode:
+		paintBackground(surface, laid.Rect, n.Style.Background, clip)
+		paintBorder(surface, laid.Rect, n.Style, laid.joined, clip)
+	case bubbleviews.ScrollNode:
+		paintScrollbar(surface, laid.scroll, n.ScrollbarColor, n.ThumbColor, clip)
+	case bubbleviews.VirtualListNode:
+		paintScrollbar(surface, laid.scroll, n.ScrollbarColor, n.ThumbColor, clip)
+	case bubbleviews.SplitNode:
+		paintDivider(surface, laid.divider, n, clip)
+	case bubbleviews.TextNode:
+		paintBackground(surface, laid.Rect, n.Style.Background, clip)
+		paintLines(surface, laid, n.Align, laid.style, clip)
+	case bubbleviews.RichTextNode:
+		paintBackground(surface, laid.Rect, n.Style.Background, clip)
+		paintRichLines(surface, laid, n.Align, clip)
+	case bubbleviews.ASCIIArtNode:
+		paintBackground(surface, laid.Rect, n.Style.Background, clip)
+		paintLines(surface, laid, n.Align, laid.style, clip)
+	case bubbleviews.LayerNode:
+		// Each layer hides whatever sits beneath its own rectangle.
+		for i := range laid.Children {
+			child := &laid.Children[i]
+			area := clip
+			if child.clip != nil {
+				area = area.intersect(*child.clip)
+			}
+			surface.fill(child.Rect.intersect(area), cellStyle{})
+			paintNode(surface, child, clip)
+		}
+		return
+	}
+
+	for i := range laid.Children {
+		paintNode(surface, &laid.Children[i], clip)
+	}
+	paintEllipsis(surface, laid.ellipsis, clip)
+}
+
+func paintScrollbar(surface *canvas, bar *scrollbar, trackColor, thumbColor bubbleviews.Color, clip Rect) {
+	if bar == nil {
+		return
+	}
+
+	track := cellStyle{fg: string(trackColor)}
+	thumb := cellStyle{fg: string(thumbColor)}
+	for y := bar.track.Y; y < bar.track.Y+bar.track.Height; y++ {
+		if bar.thumb.Contains(bar.track.X, y) {
+			surface.writeString(bar.track.X, y, "┃", thumb, clip)
+		} else {
+			surface.writeString(bar.track.X, y, "│", track, clip)
+		}
+	}
+}
+
+func paintDivider(surface *canvas, divider *Rect, split bubbleviews.SplitNode, clip Rect) {
+	if divider == nil {
+		return
+	}
+
+	line := "│"
+	if split.Direction == bubbleviews.FlexDirectionColumn {
+		line = "─"
+	}
+	style := cellStyle{fg: string(split.DividerColor)}
+	for y := divider.Y; y < divider.Y+divider.Height; y++ {
+		for x := divider.X; x < divider.X+divider.Width; x++ {
+			surface.writeString(x, y, line, style, clip)
+		}
+	}
+}
+
+// paintRichLines draws the styled rows of a rich text leaf, aligning each
+// one within the node's width like paintLines.
+func paintRichLines(surface *canvas, laid *LayoutNode, align bubbleviews.Alignment, clip Rect) {
+	for i, line := range laid.rich {
+		x := laid.Rect.X
+		if laid.aligned {
+			x += max(anchorOffset(align, laid.Rect.Width, line.width()), 0)
+		}
+		for _, run := range line {
+			x += surface.writeString(x, laid.Rect.Y+i, run.text, run.style, clip)
+		}
+	}
+}
+
+// paintBackground fills rect with color, leaving it untouched when no color
+// is set. Anything painted on top keeps the color unless it sets its own.
+func paintBackground(surface *canvas, rect Rect, color bubbleviews.Color, clip Rect) {
+	if color == "" {
+		return
+	}
+	surface.fill(rect.intersect(clip), cellStyle{bg: string(color)})
+}
+
+// paintEllipsis blanks row and marks it with an ellipsis to show that content
+// below it was cut off.
+func paintEllipsis(surface *canvas, row *Rect, clip Rect) {
+	if row == nil {
+		return
+	}
+	area := row.intersect(clip)
+	surface.clear(area)
+	surface.writeString(row.X, row.Y, "…", cellStyle{}, area)
+}
+
+func paintBorder(surface *canvas, rect Rect, style bubbleviews.BoxStyle, joined bool, clip Rect) {
+	border := mapBorderStyle(style)
+	if border == nil || rect.Empty() {
+		return
+	}
+
+	sides := borderSides(style)
+	colors := borderColors(style)
+	draw := surface.writeString
+	if joined {
+		draw = surface.join
+	}
+	right, bottom := rect.X+rect.Width-1, rect.Y+rect.Height-1
+
+	// Edges stop short of a corner only when the neighbouring edge is drawn.
+	left, top := rect.X, rect.Y
+	if sides.Left {
+		left++
+	}
+	if sides.Top {
+		top++
+	}
+	lineEnd, columnEnd := right, bottom
+	if sides.Right {
+		lineEnd--
+	}
+	if sides.Bottom {
+		columnEnd--
+	}
+
+	if sides.Top {
+		for x := left; x <= lineEnd; x++ {
+			draw(x, rect.Y, border.Top, colors.top, clip)
+		}
+	}
+	if sides.Bottom {
+		for x := left; x <= lineEnd; x++ {
+			draw(x, bottom, border.Bottom, colors.bottom, clip)
+		}
+	}
+	if sides.Left {
+		for y := top; y <= columnEnd; y++ {
+			draw(rect.X, y, border.Left, colors.left, clip)
+		}
+	}
+	if sides.Right {
+		for y := top; y <= columnEnd; y++ {
+			draw(right, y, border.Right, colors.right, clip)
+		}
+	}
+
+	if sides.Top && sides.Left {
+		draw(rect.X, rect.Y, border.TopLeft, colors.top, clip)
+	}
+	if sides.Top && sides.Right {
+		draw(right, rect.Y, border.TopRight, colors.top, clip)
+	}
+	if sides.Bottom && sides.Left {
+		draw(rect.X, bottom, border.BottomLeft, colors.bottom, clip)
+	}
+	if sides.Bottom && sides.Right {
+		draw(right, bottom, border.BottomRight, colors.bottom, clip)
+	}
+
+	// Labels sit between the corners, or between the ends of a bare line.
+	labelX, labelWidth := left-1, lineEnd-left+3
+	if sides.Top {
+		label := labelStyle(style.TitleStyle, colors.top)
+		paintBorderLabel(surface, labelX, rect.Y, labelWidth, style.Title, style.TitleAlign, label, clip)
+	}
+	if sides.Bottom {
+		label := labelStyle(style.FooterStyle, colors.bottom)
+		paintBorderLabel(surface, labelX, bottom, labelWidth, style.Footer, style.FooterAlign, label, clip)
+	}
+}
+
+// edgeStyles holds the cell style for each edge of a border.
+type edgeStyles struct {
+	top, right, bottom, left cellStyle
+}
+
+func borderColors(style bubbleviews.BoxStyle) edgeStyles {
+	edge := func(color bubbleviews.Color) cellStyle {
+		if color == "" {
+			color = style.BorderColor
+		}
+		return cellStyle{fg: string(color)}
+	}
+	return edgeStyles{
+		top:    edge(style.BorderColors.Top),
+		right:  edge(style.BorderColors.Right),
+		bottom: edge(style.BorderColors.Bottom),
+		left:   edge(style.BorderColors.Left),
+	}
+}
+
+// labelStyle resolves the style of a border label, which takes the color of
+// the edge it sits on unless it sets its own.
+func labelStyle(style bubbleviews.TextStyle, edge cellStyle) cellStyle {
+	label := textCellStyle(style)
+	if label.fg == "" {
+		label.fg = edge.fg
+	}
+	return label
+}
+
+// paintBorderLabel writes label into a horizontal border line spanning width
+// cells from x. The label keeps a border rune and a space on either side
+// while at least two cells of it remain, and is truncated with an ellipsis
+// when the box is too narrow.
+func paintBorderLabel(surface *canvas, x, y, width int, label string, align bubbleviews.Alignment, style cellStyle, clip Rect) {
+	inner := width - 2
+	if label == "" || inner <= 0 {
+		return
+	}
+
+	room := inner
+	if room >= 6 {
+		room -= 2
+	}
+	text := label
+	if room >= 4 {
+		text = " " + truncateString(label, room-2, "…") + " "
+	} else {
+		text = truncateString(label, room, "…")
+	}
+
+	offset := (inner - room) / 2
+	offset += max(anchorOffset(align, room, lipgloss.Width(text)), 0)
+	surface.writeString(x+1+offset, y, text, style, clip)
+}
+
+// paintLines draws the resolved lines of a text or ASCII art leaf, aligning
+// each one within the node's width when its parent offered one.
+func paintLines(surface *canvas, laid *LayoutNode, align bubbleviews.Alignment, style cellStyle, clip Rect) {
+	for i, line := range laid.lines {
+		x := laid.Rect.X
+		if laid.aligned {
+			x += max(anchorOffset(align, laid.Rect.Width, lipgloss.Width(line)), 0)
+		}
+		surface.writeString(x, laid.Rect.Y+i, line, style, clip)
+	}
+}
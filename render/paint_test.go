@@ -0,0 +1,101 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// backgroundMap marks every cell painted with a background by its color and
+// every other cell with a dot.
+func backgroundMap(surface *canvas) string {
+	return styleMap(surface, func(style cellStyle) string {
+		if style.bg == "" {
+			return "."
+		}
+		return style.bg
+	})
+}
+
+func TestBoxBackgroundFillsPaddingAndGaps(t *testing.T) {
+	view := bubbleviews.View{
+		Children: []bubbleviews.Node{bubbleviews.BoxNode{
+			Style: bubbleviews.BoxStyle{
+				Border:     bubbleviews.BorderThin,
+				Background: "4",
+				Padding:    bubbleviews.Padding{Top: 1, Bottom: 1, Left: 1, Right: 1},
+			},
+			Content: bubbleviews.View{Children: []bubbleviews.Node{
+				bubbleviews.FlexNode{
+					Spacing: 2,
+					Items: []bubbleviews.FlexItem{
+						{Node: bubbleviews.TextNode{Value: "a"}},
+						{Node: bubbleviews.TextNode{Value: "b"}},
+					},
+				},
+				bubbleviews.FlexNode{
+					Direction: bubbleviews.FlexDirectionColumn,
+					Spacing:   1,
+					Items: []bubbleviews.FlexItem{
+						{Node: bubbleviews.TextNode{Value: "c"}},
+						{Node: bubbleviews.TextNode{Value: "d", Style: bubbleviews.TextStyle{Background: "5"}}},
+					},
+				},
+			}},
+		}},
+	}
+
+	surface := paintCells(view)
+	want := strings.Join([]string{
+		"44444444",
+		"44444444",
+		"44444444",
+		"44444444",
+		"44444444",
+		"44544444",
+		"44444444",
+		"44444444",
+	}, "\n")
+	if got := backgroundMap(surface); got != want {
+		t.Fatalf("expected backgrounds\n%s\ngot\n%s\n\n%s", want, got, surface)
+	}
+}
+
+func TestBoxBackgroundFillsGrownBox(t *testing.T) {
+	view := bubbleviews.View{
+		Size: bubbleviews.Size{Width: 8, Height: 4},
+		Children: []bubbleviews.Node{bubbleviews.FlexNode{
+			Spacing: 1,
+			Items: []bubbleviews.FlexItem{
+				{Node: bubbleviews.BoxNode{
+					Style:   bubbleviews.BoxStyle{Background: "4", FillWidth: true, FillHeight: true},
+					Content: bubbleviews.View{Children: []bubbleviews.Node{bubbleviews.TextNode{Value: "a"}}},
+				}, Grow: 1},
+				{Node: bubbleviews.TextNode{Value: "b"}, Grow: 1},
+			},
+		}},
+	}
+
+	// The box fills its growing item; the flex gap beside it stays clear.
+	want := strings.Join([]string{"4444....", "4444....", "4444....", "4444...."}, "\n")
+	if got := backgroundMap(paintCells(view)); got != want {
+		t.Fatalf("expected backgrounds\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestDeprecatedTextFieldsFoldIntoStyle(t *testing.T) {
+	view := bubbleviews.View{Children: []bubbleviews.Node{
+		bubbleviews.TextNode{Value: "a", Color: "1", Bold: true},
+		bubbleviews.TextNode{Value: "b", Color: "1", Style: bubbleviews.TextStyle{Color: "2", Italic: true}},
+		bubbleviews.ASCIIArtNode{Lines: []string{"c"}, Color: "3", Bold: true},
+	}}
+
+	surface := paintCells(view)
+	want := []cellStyle{{fg: "1", bold: true}, {fg: "2", italic: true}, {fg: "3", bold: true}}
+	for y, style := range want {
+		if got := surface.cells[y][0].style; got != style {
+			t.Fatalf("row %d: expected %+v, got %+v", y, style, got)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+// textRun is a stretch of text painted in a single style.
+type textRun struct {
+	text  string
+	style cellStyle
+}
+
+// richLine is one row of a rich text leaf.
+type richLine []textRun
+
+func (l richLine) width() int {
+	width := 0
+	for _, run := range l {
+		width += lipgloss.Width(run.text)
+	}
+	return width
+}
+
+// add appends text to the line, extending the last run when it shares style.
+func (l richLine) add(text string, style cellStyle) richLine {
+	if text == "" {
+		return l
+	}
+	if last := len(l) - 1; last >= 0 && l[last].style == style {
+		l[last].text += text
+		return l
+	}
+	return append(l, textRun{text: text, style: style})
+}
+
+func layoutRichText(text bubbleviews.RichTextNode, parentSize bubbleviews.Size) LayoutNode {
+	width := parentSize.Width
+	runs := make([]textRun, 0, len(text.Spans))
+	for _, span := range text.Spans {
+		style := textCellStyle(span.Style.Inherit(text.Style))
+		runs = append(runs, textRun{text: span.Text, style: style})
+	}
+
+	var paragraphs []richLine
+	if text.Wrap && width > 0 {
+		paragraphs = wrapRuns(runs, width, text.WrapMode, text.Hyphenate)
+	} else {
+		paragraphs = splitRunLines(runs)
+	}
+
+	lines := make([]richLine, 0, len(paragraphs))
+	for _, line := range paragraphs {
+		if text.Truncate && width > 0 {
+			line = truncateLine(line, width, text.TruncateSuffix)
+		}
+		lines = append(lines, line)
+	}
+
+	lineWidth := 0
+	for _, line := range lines {
+		lineWidth = max(lineWidth, line.width())
+	}
+
+	return LayoutNode{
+		Rect:    Rect{Width: max(width, lineWidth), Height: len(lines)},
+		rich:    lines,
+		aligned: width > 0,
+	}
+}
+
+// splitRunLines breaks runs on newlines and expands tabs, as splitLines does
+// for plain text.
+func splitRunLines(runs []textRun) []richLine {
+	lines := []richLine{nil}
+	for _, run := range runs {
+		for i, part := range splitLines(run.text) {
+			if i > 0 {
+				lines = append(lines, nil)
+			}
+			last := len(lines) - 1
+			lines[last] = lines[last].add(part, run.style)
+		}
+	}
+	return lines
+}
+
+// truncateLine is the span-aware counterpart of truncateString. The suffix
+// takes the style of the last run kept.
+func truncateLine(line richLine, width int, suffix string) richLine {
+	if width <= 0 || line.width() <= width {
+		return line
+	}
+
+	if suffix == "" {
+		suffix = "..."
+	}
+
+	suffixWidth := lipgloss.Width(suffix)
+	if suffixWidth >= width {
+		head, _ := cutLine(line, width)
+		return head
+	}
+
+	head, _ := cutLine(line, width-suffixWidth)
+	var style cellStyle
+	if len(head) > 0 {
+		style = head[len(head)-1].style
+	} else if len(line) > 0 {
+		style = line[0].style
+	}
+	return head.add(suffix, style)
+}
+
+// cutLine splits line after at most width cells. At least one grapheme
+// moves to the head so repeated cuts always make progress.
+func cutLine(line richLine, width int) (richLine, richLine) {
+	var head, tail richLine
+	used, cut := 0, false
+	for _, run := range line {
+		var kept, rest strings.Builder
+		for _, r := range run.text {
+			rw := lipgloss.Width(string(r))
+			if !cut && (used+rw <= width || used == 0) {
+				kept.WriteRune(r)
+				used += rw
+				continue
+			}
+			cut = true
+			rest.WriteRune(r)
+		}
+		head = head.add(kept.String(), run.style)
+		tail = tail.add(rest.String(), run.style)
+	}
+	return head, tail
+}
@@ -0,0 +1,74 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func richTextView(width int, text bubbleviews.RichTextNode) bubbleviews.View {
+	return bubbleviews.View{
+		Size:     bubbleviews.Size{Width: width},
+		Children: []bubbleviews.Node{text},
+	}
+}
+
+func TestRichTextWrapsAcrossSpans(t *testing.T) {
+	laid := Layout(richTextView(12, bubbleviews.RichTextNode{
+		Wrap: true,
+		Spans: []bubbleviews.Span{
+			{Text: "FPS: "},
+			{Text: "30", Style: bubbleviews.TextStyle{Color: "42", Bold: true}},
+			{Text: "fps steady and "},
+			{Text: "healthy", Style: bubbleviews.TextStyle{Color: "36"}},
+		},
+	}))
+
+	var got []string
+	for _, line := range laid.Nodes[0].rich {
+		var plain strings.Builder
+		for _, run := range line {
+			plain.WriteString(run.text)
+		}
+		got = append(got, plain.String())
+	}
+	want := []string{"FPS: 30fps", "steady and", "healthy"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("expected lines %q, got %q", want, got)
+	}
+
+	first := laid.Nodes[0].rich[0]
+	if len(first) != 3 || first[1].text != "30" || !first[1].style.bold || first[1].style.fg != "42" {
+		t.Fatalf("expected the number to keep its own style, got %+v", first)
+	}
+}
+
+func TestRichTextTruncatesWithSuffix(t *testing.T) {
+	out := Render(richTextView(10, bubbleviews.RichTextNode{
+		Truncate:       true,
+		TruncateSuffix: "…",
+		Spans: []bubbleviews.Span{
+			{Text: "Camera "},
+			{Text: "Loading Dock", Style: bubbleviews.TextStyle{Bold: true}},
+		},
+	}))
+
+	if plain := strings.TrimRight(ansi.Strip(out), " "); plain != "Camera Lo…" {
+		t.Fatalf("expected truncated line, got %q", plain)
+	}
+}
+
+func TestRichTextWithoutWrapStaysOnOneLine(t *testing.T) {
+	laid := Layout(richTextView(8, bubbleviews.RichTextNode{
+		Spans: []bubbleviews.Span{
+			{Text: "Camera "},
+			{Text: "Loading Dock", Style: bubbleviews.TextStyle{Bold: true}},
+		},
+	}))
+
+	if lines := laid.Nodes[0].rich; len(lines) != 1 || lines[0].width() != 19 {
+		t.Fatalf("expected one unbroken line, got %+v", lines)
+	}
+}
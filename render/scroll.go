@@ -0,0 +1,99 @@
+package render
+
+import "github.com/sprucelabsai-community/bubbleviews"
+
+// scrollbar is the resolved track and thumb of a vertical scrollbar.
+type scrollbar struct {
+	track Rect
+	thumb Rect
+}
+
+// layoutScroll lays the child out at its intrinsic height and windows it into
+// the offered size. Without an offered height the viewport grows to fit the
+// child, so nothing scrolls.
+func layoutScroll(scroll bubbleviews.ScrollNode, parentSize bubbleviews.Size) LayoutNode {
+	if scroll.Node == nil {
+		return LayoutNode{}
+	}
+
+	bar := 0
+	if scroll.Scrollbar {
+		bar = 1
+	}
+
+	viewportWidth := 0
+	if parentSize.Width > 0 {
+		viewportWidth = max(parentSize.Width-bar, 1)
+	}
+	child := layoutNode(scroll.Node, bubbleviews.Size{Width: viewportWidth})
+	contentWidth, contentHeight := child.outerWidth(), child.outerHeight()
+
+	if viewportWidth == 0 {
+		viewportWidth = contentWidth
+	}
+	viewportHeight := parentSize.Height
+	if viewportHeight <= 0 {
+		viewportHeight = contentHeight
+	}
+
+	offsetY := bubbleviews.ClampScroll(scroll.OffsetY, contentHeight, viewportHeight)
+	child.place(
+		-bubbleviews.ClampScroll(scroll.OffsetX, contentWidth, viewportWidth),
+		-offsetY,
+	)
+	viewport := Rect{Width: viewportWidth, Height: viewportHeight}
+	child.clip = &viewport
+
+	laid := LayoutNode{
+		Rect:     Rect{Width: viewportWidth + bar, Height: viewportHeight},
+		Content:  viewport,
+		Children: []LayoutNode{child},
+	}
+	if scroll.Scrollbar && viewportHeight > 0 {
+		track := Rect{X: viewportWidth, Width: 1, Height: viewportHeight}
+		laid.scroll = &scrollbar{
+			track: track,
+			thumb: scrollThumb(track, offsetY, contentHeight),
+		}
+	}
+	return laid
+}
+
+// scrollThumb sizes the thumb to the visible share of the content and slides
+// it along the track in proportion to offset.
+func scrollThumb(track Rect, offset, content int) Rect {
+	viewport := track.Height
+	if content <= viewport {
+		return track
+	}
+
+	size := max(viewport*viewport/content, 1)
+	travel := viewport - size
+	scrollable := content - viewport
+	position := (offset*travel + scrollable/2) / scrollable
+
+	return Rect{X: track.X, Y: track.Y + position, Width: track.Width, Height: size}
+}
+
+// ScrollExtent reports the full size of a scrollable node's content alongside
+// the viewport it is windowed into, so models can clamp their offsets with
+// bubbleviews.ClampScroll. VirtualListNode content is Count rows of
+// RowHeight cells; divide by RowHeight to clamp its row offset. It reports
+// false for any other node.
+func (n LayoutNode) ScrollExtent() (content, viewport bubbleviews.Size, ok bool) {
+	viewport = bubbleviews.Size{Width: n.Content.Width, Height: n.Content.Height}
+
+	switch node := unwrapNode(n.Node).(type) {
+	case bubbleviews.ScrollNode:
+		if len(n.Children) == 0 {
+			return bubbleviews.Size{}, bubbleviews.Size{}, false
+		}
+		child := n.Children[0]
+		return bubbleviews.Size{Width: child.outerWidth(), Height: child.outerHeight()}, viewport, true
+	case bubbleviews.VirtualListNode:
+		height := max(node.Count, 0) * max(node.RowHeight, 1)
+		return bubbleviews.Size{Width: viewport.Width, Height: height}, viewport, true
+	default:
+		return bubbleviews.Size{}, bubbleviews.Size{}, false
+	}
+}
@@ -0,0 +1,93 @@
+package render
+
+import "github.com/sprucelabsai-community/bubbleviews"
+
+// layoutSplit lays out both panes of a split on either side of its divider.
+// Without an offered length along the split's axis each pane takes its
+// natural size.
+func layoutSplit(split bubbleviews.SplitNode, parentSize bubbleviews.Size) LayoutNode {
+	row := split.Direction != bubbleviews.FlexDirectionColumn
+	total, cross := parentSize.Width, parentSize.Height
+	if !row {
+		total, cross = parentSize.Height, parentSize.Width
+	}
+
+	firstLength, secondLength := 0, 0
+	if total > 0 {
+		firstLength, secondLength = split.PaneSizes(total)
+	}
+
+	first := layoutPane(split.First, firstLength, cross, row)
+	second := layoutPane(split.Second, secondLength, cross, row)
+	if total <= 0 {
+		firstLength, secondLength = first.main, second.main
+	}
+	if cross <= 0 {
+		cross = max(first.cross, second.cross)
+	}
+
+	length := firstLength + 1 + secondLength
+	firstSlot := Rect{Width: firstLength, Height: cross}
+	divider := Rect{X: firstLength, Width: 1, Height: cross}
+	secondSlot := Rect{X: firstLength + 1, Width: secondLength, Height: cross}
+	bounds := Rect{Width: length, Height: cross}
+	if !row {
+		firstSlot, divider, secondSlot, bounds = transpose(firstSlot), transpose(divider), transpose(secondSlot), transpose(bounds)
+	}
+
+	children := make([]LayoutNode, 0, 2)
+	for _, pane := range []struct {
+		laid *LayoutNode
+		slot Rect
+	}{{first.laid, firstSlot}, {second.laid, secondSlot}} {
+		if pane.laid == nil {
+			continue
+		}
+		pane.laid.place(pane.slot.X, pane.slot.Y)
+		clip := pane.slot
+		pane.laid.clip = &clip
+		children = append(children, *pane.laid)
+	}
+
+	return LayoutNode{
+		Rect:     bounds,
+		Children: children,
+		divider:  &divider,
+	}
+}
+
+// splitPane is a pane laid out along with its footprint on the split's axes.
+type splitPane struct {
+	laid        *LayoutNode
+	main, cross int
+}
+
+func layoutPane(node bubbleviews.Node, length, cross int, row bool) splitPane {
+	if node == nil {
+		return splitPane{}
+	}
+
+	size := bubbleviews.Size{Width: length, Height: cross}
+	if !row {
+		size = bubbleviews.Size{Width: cross, Height: length}
+	}
+	laid := layoutNode(node, size)
+	if row {
+		return splitPane{laid: &laid, main: laid.outerWidth(), cross: laid.outerHeight()}
+	}
+	return splitPane{laid: &laid, main: laid.outerHeight(), cross: laid.outerWidth()}
+}
+
+// transpose swaps a rect's axes, turning row geometry into column geometry.
+func transpose(r Rect) Rect {
+	return Rect{X: r.Y, Y: r.X, Width: r.Height, Height: r.Width}
+}
+
+// Divider reports where a SplitNode's divider landed. It reports false for
+// any other node.
+func (n LayoutNode) Divider() (Rect, bool) {
+	if n.divider == nil {
+		return Rect{}, false
+	}
+	return *n.divider, true
+}
@@ -0,0 +1,68 @@
+package render
+
+import "github.com/sprucelabsai-community/bubbleviews"
+
+// layoutVirtualList builds and lays out only the rows that fit the offered
+// height, so the cost tracks the viewport rather than Count.
+func layoutVirtualList(list bubbleviews.VirtualListNode, parentSize bubbleviews.Size) LayoutNode {
+	if list.Count <= 0 || list.Row == nil {
+		return LayoutNode{}
+	}
+
+	rowHeight := max(list.RowHeight, 1)
+	bar := 0
+	if list.Scrollbar {
+		bar = 1
+	}
+
+	viewportWidth := 0
+	if parentSize.Width > 0 {
+		viewportWidth = max(parentSize.Width-bar, 1)
+	}
+	viewportHeight := parentSize.Height
+	if viewportHeight <= 0 {
+		viewportHeight = list.Count * rowHeight
+	}
+
+	visible := min((viewportHeight+rowHeight-1)/rowHeight, list.Count)
+	first := bubbleviews.ClampScroll(list.Offset, list.Count, max(viewportHeight/rowHeight, 1))
+
+	viewport := Rect{Width: viewportWidth, Height: viewportHeight}
+	children := make([]LayoutNode, 0, visible)
+	slots := make([]int, 0, visible)
+	for index := first; index < list.Count && len(children) < visible; index++ {
+		row := list.Row(index)
+		if row == nil {
+			continue
+		}
+
+		y := (index - first) * rowHeight
+		child := layoutNode(row, bubbleviews.Size{Width: viewportWidth, Height: rowHeight})
+		child.place(0, y)
+		if viewportWidth == 0 {
+			viewport.Width = max(viewport.Width, child.outerWidth())
+		}
+		children = append(children, child)
+		slots = append(slots, y)
+	}
+
+	// Rows are clipped to their own slot so a tall row cannot cover the next.
+	for i, y := range slots {
+		clip := Rect{Y: y, Width: viewport.Width, Height: rowHeight}.intersect(viewport)
+		children[i].clip = &clip
+	}
+
+	laid := LayoutNode{
+		Rect:     Rect{Width: viewport.Width + bar, Height: viewportHeight},
+		Content:  viewport,
+		Children: children,
+	}
+	if list.Scrollbar && viewportHeight > 0 {
+		track := Rect{X: viewport.Width, Width: 1, Height: viewportHeight}
+		laid.scroll = &scrollbar{
+			track: track,
+			thumb: scrollThumb(track, first*rowHeight, list.Count*rowHeight),
+		}
+	}
+	return laid
+}
@@ -0,0 +1,84 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func virtualListView(count, offset int, built *int) bubbleviews.View {
+	return bubbleviews.View{
+		Size: bubbleviews.Size{Width: 80, Height: 24},
+		Children: []bubbleviews.Node{
+			bubbleviews.VirtualListNode{
+				Count:     count,
+				Offset:    offset,
+				Scrollbar: true,
+				Row: func(index int) bubbleviews.Node {
+					*built++
+					return bubbleviews.TextNode{Value: fmt.Sprintf("row %d", index)}
+				},
+			},
+		},
+	}
+}
+
+func TestVirtualListBuildsOnlyVisibleRows(t *testing.T) {
+	built := 0
+	out := Render(virtualListView(100_000, 50_000, &built))
+
+	if built != 24 {
+		t.Fatalf("expected 24 rows to be built, got %d", built)
+	}
+	lines := strings.Split(out, "\n")
+	if len(lines) != 24 || !strings.HasPrefix(lines[0], "row 50000") {
+		t.Fatalf("unexpected window:\n%s", out)
+	}
+	t.Logf("\n%s", out)
+}
+
+func TestVirtualListRowsTallerThanTheViewport(t *testing.T) {
+	tests := []struct {
+		offset int
+		want   string
+	}{
+		{offset: 0, want: "row 0"},
+		{offset: 42, want: "row 42"},
+		{offset: 100, want: "row 99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.offset), func(t *testing.T) {
+			out := Render(bubbleviews.View{
+				Size: bubbleviews.Size{Width: 10, Height: 2},
+				Children: []bubbleviews.Node{bubbleviews.VirtualListNode{
+					Count:     100,
+					RowHeight: 3,
+					Offset:    tt.offset,
+					Row: func(index int) bubbleviews.Node {
+						return bubbleviews.TextNode{Value: fmt.Sprintf("row %d", index)}
+					},
+				}},
+			})
+			lines := strings.Split(out, "\n")
+			if len(lines) != 2 || strings.TrimRight(lines[0], " ") != tt.want {
+				t.Fatalf("expected %q on the first of two lines, got\n%s", tt.want, out)
+			}
+		})
+	}
+}
+
+func BenchmarkRenderVirtualList(b *testing.B) {
+	for _, count := range []int{1_000, 100_000, 10_000_000} {
+		b.Run(fmt.Sprintf("rows=%d", count), func(b *testing.B) {
+			built := 0
+			view := virtualListView(count, count/2, &built)
+			b.ReportAllocs()
+			for b.Loop() {
+				Render(view)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package render
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+const softHyphen = "\u00ad"
+
+// wrapText breaks text into lines of at most width cells. It is the plain
+// text counterpart of wrapRuns.
+func wrapText(text string, width int, mode bubbleviews.WrapMode, hyphenate bool) []string {
+	if width <= 0 {
+		return []string{text}
+	}
+
+	wrapped := wrapRuns([]textRun{{text: text}}, width, mode, hyphenate)
+	lines := make([]string, len(wrapped))
+	for i, line := range wrapped {
+		var plain strings.Builder
+		for _, run := range line {
+			plain.WriteString(run.text)
+		}
+		lines[i] = plain.String()
+	}
+	return lines
+}
+
+// wrapRuns breaks runs into lines of at most width cells. Newlines always end
+// a line; within a line, breaks follow mode regardless of run boundaries.
+func wrapRuns(runs []textRun, width int, mode bubbleviews.WrapMode, hyphenate bool) []richLine {
+	var lines []richLine
+	for _, paragraph := range splitRunLines(runs) {
+		if mode == bubbleviews.WrapCharacter {
+			lines = append(lines, wrapCharacters(paragraph, width)...)
+			continue
+		}
+		w := lineWrapper{width: width, hyphenate: hyphenate, preserve: mode == bubbleviews.WrapPreserve}
+		lines = append(lines, w.wrap(paragraph)...)
+	}
+	return lines
+}
+
+// wrapCharacters cuts a line into rows of exactly width cells.
+func wrapCharacters(line richLine, width int) []richLine {
+	line = stripSoftHyphens(line)
+	var lines []richLine
+	for line.width() > width {
+		var head richLine
+		head, line = cutLine(line, width)
+		lines = append(lines, head)
+	}
+	return append(lines, line)
+}
+
+// lineWrapper packs the words of one paragraph greedily into lines.
+type lineWrapper struct {
+	width     int
+	hyphenate bool
+	preserve  bool // keep whitespace as written instead of collapsing it
+
+	lines   []richLine
+	line    richLine
+	started bool     // line holds a word or preserved indentation
+	gap     richLine // whitespace waiting to be placed before the next word
+}
+
+func (w *lineWrapper) wrap(paragraph richLine) []richLine {
+	var word, space richLine
+	inSpace := false
+	flushToken := func() {
+		if inSpace {
+			w.space(space)
+		} else {
+			w.word(word)
+		}
+		word, space = nil, nil
+	}
+
+	for _, run := range paragraph {
+		for _, r := range run.text {
+			if unicode.IsSpace(r) != inSpace && (len(word) > 0 || len(space) > 0) {
+				flushToken()
+			}
+			inSpace = unicode.IsSpace(r)
+			if inSpace {
+				space = space.add(string(r), run.style)
+			} else {
+				word = word.add(string(r), run.style)
+			}
+		}
+	}
+	if len(word) > 0 || len(space) > 0 {
+		flushToken()
+	}
+
+	// Preserved trailing whitespace stays when it fits, as it would have
+	// between two words.
+	if w.preserve && w.started && w.line.width()+w.gap.width() <= w.width {
+		w.line = appendLine(w.line, w.gap)
+	}
+	return append(w.lines, w.line)
+}
+
+// space records whitespace before the next word. Collapsed whitespace becomes
+// a single space in the style of the whitespace it replaces; preserved
+// whitespace at the start of a paragraph is kept as indentation.
+func (w *lineWrapper) space(space richLine) {
+	switch {
+	case !w.preserve:
+		w.gap = richLine{{text: " ", style: space[0].style}}
+	case !w.started && len(w.lines) == 0:
+		w.line = appendLine(w.line, space)
+		w.started = true
+	default:
+		w.gap = space
+	}
+}
+
+func (w *lineWrapper) word(word richLine) {
+	for len(word) > 0 {
+		gap := w.gap
+		if !w.started {
+			gap = nil
+		}
+
+		visible := stripSoftHyphens(word)
+		if w.line.width()+gap.width()+visible.width() <= w.width {
+			w.line = appendLine(appendLine(w.line, gap), visible)
+			w.started = true
+			w.gap = nil
+			return
+		}
+
+		// Break at the last soft hyphen that leaves room for its hyphen.
+		if head, tail, ok := cutAtSoftHyphen(word, w.width-w.line.width()-gap.width()); ok {
+			w.line = appendLine(appendLine(w.line, gap), head)
+			w.newLine()
+			word = tail
+			continue
+		}
+
+		// Move the word to a fresh line before breaking it.
+		if w.started {
+			w.newLine()
+			continue
+		}
+
+		room := w.width
+		if w.hyphenate && w.width > 1 {
+			room--
+		}
+		head, tail := cutLine(word, room)
+		head = stripSoftHyphens(head)
+		if w.hyphenate && len(tail) > 0 && w.width > 1 {
+			head = head.add("-", head[len(head)-1].style)
+		}
+		w.line = head
+		w.newLine()
+		word = tail
+	}
+}
+
+func (w *lineWrapper) newLine() {
+	w.lines = append(w.lines, w.line)
+	w.line, w.gap, w.started = nil, nil, false
+}
+
+// cutAtSoftHyphen splits word at its last soft hyphen whose head, with a
+// hyphen added, fits in room cells.
+func cutAtSoftHyphen(word richLine, room int) (richLine, richLine, bool) {
+	var head, best, tail richLine
+	found := false
+	for i, run := range word {
+		parts := strings.Split(run.text, softHyphen)
+		for j, part := range parts {
+			if j > 0 {
+				candidate := append(richLine(nil), head...).add("-", run.style)
+				if candidate.width() > room {
+					return best, tail, found
+				}
+				best = candidate
+				tail = append(richLine{{text: strings.Join(parts[j:], softHyphen), style: run.style}}, word[i+1:]...)
+				found = true
+			}
+			head = head.add(part, run.style)
+		}
+	}
+	return best, tail, found
+}
+
+func stripSoftHyphens(line richLine) richLine {
+	var stripped richLine
+	for _, run := range line {
+		stripped = stripped.add(strings.ReplaceAll(run.text, softHyphen, ""), run.style)
+	}
+	return stripped
+}
+
+// appendLine extends line with every run of more.
+func appendLine(line, more richLine) richLine {
+	for _, run := range more {
+		line = line.add(run.text, run.style)
+	}
+	return line
+}
@@ -0,0 +1,90 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sprucelabsai-community/bubbleviews"
+)
+
+func TestWrapTextModes(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		width     int
+		mode      bubbleviews.WrapMode
+		hyphenate bool
+		want      []string
+	}{
+		{
+			name:  "word mode collapses whitespace",
+			text:  "motion   detected on\tdock",
+			width: 10,
+			want:  []string{"motion", "detected", "on dock"},
+		},
+		{
+			name:  "newlines start new lines",
+			text:  "Dock 1\n\nLobby camera offline",
+			width: 12,
+			want:  []string{"Dock 1", "", "Lobby camera", "offline"},
+		},
+		{
+			name:  "long words break instead of overflowing",
+			text:  "id cam-1729384756 ok",
+			width: 8,
+			want:  []string{"id", "cam-1729", "384756", "ok"},
+		},
+		{
+			name:      "hyphenated breaks",
+			text:      "id cam1729384756",
+			width:     8,
+			hyphenate: true,
+			want:      []string{"id", "cam1729-", "384756"},
+		},
+		{
+			name:  "soft hyphens break with a hyphen",
+			text:  "the re\u00adcord\u00ading stopped",
+			width: 10,
+			want:  []string{"the re-", "cording", "stopped"},
+		},
+		{
+			name:  "character mode fills every line",
+			text:  "motion detected",
+			width: 4,
+			mode:  bubbleviews.WrapCharacter,
+			want:  []string{"moti", "on d", "etec", "ted"},
+		},
+		{
+			name:  "preserve mode keeps indentation and spacing",
+			text:  "  key:  value\n    nested:  yes",
+			width: 13,
+			mode:  bubbleviews.WrapPreserve,
+			want:  []string{"  key:  value", "    nested:", "yes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wrapText(tt.text, tt.width, tt.mode, tt.hyphenate)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRichTextWrapModesKeepSpanStyles(t *testing.T) {
+	laid := Layout(richTextView(6, bubbleviews.RichTextNode{
+		Wrap:     true,
+		WrapMode: bubbleviews.WrapCharacter,
+		Spans: []bubbleviews.Span{
+			{Text: "cam-"},
+			{Text: "17293", Style: bubbleviews.TextStyle{Bold: true}},
+		},
+	}))
+
+	lines := laid.Nodes[0].rich
+	if len(lines) != 2 || len(lines[0]) != 2 || lines[0][1].text != "17" || !lines[0][1].style.bold || lines[1][0].text != "293" {
+		t.Fatalf("expected the bold span split across lines, got %+v", lines)
+	}
+}
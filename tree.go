@@ -0,0 +1,261 @@
+package bubbleviews
+
+// NodeID returns the ID assigned to node, or "" when it has none.
+func NodeID(node Node) string {
+	if node == nil || isNilNode(node) {
+		return ""
+	}
+	return node.nodeID()
+}
+
+// Children returns the nodes directly nested inside node in paint order. Leaf
+// nodes have no children, and neither do a VirtualListNode or a MarkdownNode,
+// whose content only exists while they are laid out.
+func Children(node Node) []Node {
+	var children []Node
+	switch n := derefNode(node).(type) {
+	case BoxNode:
+		children = append(children, n.Content.Children...)
+	case FlexNode:
+		for _, item := range n.Items {
+			children = append(children, item.Node)
+		}
+	case MarginNode:
+		children = append(children, n.Node)
+	case ScrollNode:
+		children = append(children, n.Node)
+	case SplitNode:
+		children = append(children, n.First, n.Second)
+	case FlowNode:
+		children = append(children, n.Items...)
+	case GridNode:
+		for _, cell := range n.Cells {
+			children = append(children, cell.Node)
+		}
+	case LayerNode:
+		for _, layer := range n.Layers {
+			children = append(children, layer.Node)
+		}
+	}
+	return children
+}
+
+// Find returns the first node with the given ID, searching depth-first in
+// paint order.
+func (v View) Find(id string) (Node, bool) {
+	path, ok := v.Path(id)
+	if !ok {
+		return nil, false
+	}
+	return path[len(path)-1], true
+}
+
+// Path returns the chain of nodes leading to the node with the given ID,
+// starting at one of the view's children and ending at the match itself.
+func (v View) Path(id string) ([]Node, bool) {
+	if id == "" {
+		return nil, false
+	}
+	for _, child := range v.Children {
+		if path, ok := findPath(child, id); ok {
+			return path, true
+		}
+	}
+	return nil, false
+}
+
+// Replace returns a copy of the view with the node identified by id swapped
+// for replacement. Only the containers along the path to the match are
+// copied; the receiver and every other subtree are left untouched. It reports
+// false and returns the view unchanged when no node has the ID.
+func (v View) Replace(id string, replacement Node) (View, bool) {
+	if id == "" {
+		return v, false
+	}
+	for i, child := range v.Children {
+		updated, ok := replaceNode(child, id, replacement)
+		if !ok {
+			continue
+		}
+		children := append([]Node(nil), v.Children...)
+		children[i] = updated
+		v.Children = children
+		return v, true
+	}
+	return v, false
+}
+
+func findPath(node Node, id string) ([]Node, bool) {
+	if node == nil {
+		return nil, false
+	}
+	if NodeID(node) == id {
+		return []Node{node}, true
+	}
+	for _, child := range Children(node) {
+		if path, ok := findPath(child, id); ok {
+			return append([]Node{node}, path...), true
+		}
+	}
+	return nil, false
+}
+
+func replaceNode(node Node, id string, replacement Node) (Node, bool) {
+	if node == nil {
+		return nil, false
+	}
+	if NodeID(node) == id {
+		return replacement, true
+	}
+
+	children := Children(node)
+	for i, child := range children {
+		updated, ok := replaceNode(child, id, replacement)
+		if !ok {
+			continue
+		}
+		children[i] = updated
+		return withChildren(node, children), true
+	}
+	return node, false
+}
+
+// withChildren returns a copy of node whose children are swapped for
+// children, which must line up with what Children(node) returned. Pointer
+// nodes come back as pointers to the copy.
+func withChildren(node Node, children []Node) Node {
+	switch n := node.(type) {
+	case *BoxNode:
+		updated := withChildren(*n, children).(BoxNode)
+		return &updated
+	case *MarginNode:
+		updated := withChildren(*n, children).(MarginNode)
+		return &updated
+	case *ScrollNode:
+		updated := withChildren(*n, children).(ScrollNode)
+		return &updated
+	case *SplitNode:
+		updated := withChildren(*n, children).(SplitNode)
+		return &updated
+	case *FlexNode:
+		updated := withChildren(*n, children).(FlexNode)
+		return &updated
+	case *FlowNode:
+		updated := withChildren(*n, children).(FlowNode)
+		return &updated
+	case *GridNode:
+		updated := withChildren(*n, children).(GridNode)
+		return &updated
+	case *LayerNode:
+		updated := withChildren(*n, children).(LayerNode)
+		return &updated
+	case BoxNode:
+		n.Content.Children = children
+		return n
+	case FlexNode:
+		items := append([]FlexItem(nil), n.Items...)
+		for i := range items {
+			items[i].Node = children[i]
+		}
+		n.Items = items
+		return n
+	case MarginNode:
+		n.Node = children[0]
+		return n
+	case ScrollNode:
+		n.Node = children[0]
+		return n
+	case SplitNode:
+		n.First, n.Second = children[0], children[1]
+		return n
+	case FlowNode:
+		n.Items = children
+		return n
+	case GridNode:
+		cells := append([]GridCell(nil), n.Cells...)
+		for i := range cells {
+			cells[i].Node = children[i]
+		}
+		n.Cells = cells
+		return n
+	case LayerNode:
+		layers := append([]Layer(nil), n.Layers...)
+		for i := range layers {
+			layers[i].Node = children[i]
+		}
+		n.Layers = layers
+		return n
+	default:
+		return node
+	}
+}
+
+// derefNode dereferences pointer nodes, mapping nil pointers to nil.
+func derefNode(node Node) Node {
+	if isNilNode(node) {
+		return nil
+	}
+	switch n := node.(type) {
+	case *BoxNode:
+		return *n
+	case *MarginNode:
+		return *n
+	case *ScrollNode:
+		return *n
+	case *SplitNode:
+		return *n
+	case *VirtualListNode:
+		return *n
+	case *FlexNode:
+		return *n
+	case *FlowNode:
+		return *n
+	case *GridNode:
+		return *n
+	case *LayerNode:
+		return *n
+	case *ASCIIArtNode:
+		return *n
+	case *TextNode:
+		return *n
+	case *RichTextNode:
+		return *n
+	case *MarkdownNode:
+		return *n
+	default:
+		return node
+	}
+}
+
+func isNilNode(node Node) bool {
+	switch n := node.(type) {
+	case *BoxNode:
+		return n == nil
+	case *MarginNode:
+		return n == nil
+	case *ScrollNode:
+		return n == nil
+	case *SplitNode:
+		return n == nil
+	case *VirtualListNode:
+		return n == nil
+	case *FlexNode:
+		return n == nil
+	case *FlowNode:
+		return n == nil
+	case *GridNode:
+		return n == nil
+	case *LayerNode:
+		return n == nil
+	case *ASCIIArtNode:
+		return n == nil
+	case *TextNode:
+		return n == nil
+	case *RichTextNode:
+		return n == nil
+	case *MarkdownNode:
+		return n == nil
+	default:
+		return false
+	}
+}
@@ -0,0 +1,126 @@
+package bubbleviews
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// treeFixture nests value and pointer containers, with unnamed nodes beside
+// the named ones so the empty ID has something to match.
+func treeFixture() View {
+	return View{
+		Children: []Node{
+			TextNode{ID: "title", Value: "Cameras"},
+			&BoxNode{
+				ID: "panel",
+				Content: View{Children: []Node{
+					FlexNode{ID: "row", Items: []FlexItem{
+						{Node: TextNode{ID: "dock", Value: "Dock"}},
+						{Node: &MarginNode{ID: "lobby-margin", Node: TextNode{ID: "lobby", Value: "Lobby"}}},
+						{Node: TextNode{Value: "unnamed"}},
+					}},
+				}},
+			},
+			&GridNode{ID: "grid", Cells: []GridCell{{Node: SplitNode{
+				ID:     "split",
+				First:  TextNode{ID: "left"},
+				Second: TextNode{ID: "right"},
+			}}}},
+		},
+	}
+}
+
+func pathIDs(path []Node) []string {
+	ids := make([]string, len(path))
+	for i, node := range path {
+		ids[i] = NodeID(node)
+	}
+	return ids
+}
+
+func TestViewPath(t *testing.T) {
+	tests := []struct {
+		id   string
+		want []string
+		ok   bool
+	}{
+		{id: "title", want: []string{"title"}, ok: true},
+		{id: "panel", want: []string{"panel"}, ok: true},
+		{id: "dock", want: []string{"panel", "row", "dock"}, ok: true},
+		{id: "lobby", want: []string{"panel", "row", "lobby-margin", "lobby"}, ok: true},
+		{id: "right", want: []string{"grid", "split", "right"}, ok: true},
+		{id: "missing"},
+		{id: ""},
+	}
+
+	view := treeFixture()
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			path, ok := view.Path(tt.id)
+			if ok != tt.ok || fmt.Sprint(pathIDs(path)) != fmt.Sprint(tt.want) {
+				t.Fatalf("expected %v (%v), got %v (%v)", tt.want, tt.ok, pathIDs(path), ok)
+			}
+
+			node, found := view.Find(tt.id)
+			if found != tt.ok || (found && NodeID(node) != tt.id) {
+				t.Fatalf("expected Find to agree with Path, got %v (%v)", NodeID(node), found)
+			}
+		})
+	}
+}
+
+func TestViewFindReturnsPointerNodesAsGiven(t *testing.T) {
+	view := treeFixture()
+	node, ok := view.Find("panel")
+	if !ok || node != view.Children[1] {
+		t.Fatalf("expected the panel pointer itself, got %#v", node)
+	}
+}
+
+func TestViewReplaceCopiesOnlyThePath(t *testing.T) {
+	view := treeFixture()
+	updated, ok := view.Replace("lobby", TextNode{ID: "lobby", Value: "Lobby offline"})
+	if !ok {
+		t.Fatal("expected the lobby node to be replaced")
+	}
+
+	if !reflect.DeepEqual(view, treeFixture()) {
+		t.Fatal("expected the original view to be left unchanged")
+	}
+	if node, _ := updated.Find("lobby"); node.(TextNode).Value != "Lobby offline" {
+		t.Fatalf("expected the replacement in the copy, got %#v", node)
+	}
+
+	panel, ok := updated.Children[1].(*BoxNode)
+	if !ok || panel == view.Children[1] {
+		t.Fatalf("expected a pointer to a copied panel, got %#v", updated.Children[1])
+	}
+	if _, ok := panel.Content.Children[0].(FlexNode).Items[1].Node.(*MarginNode); !ok {
+		t.Fatal("expected the margin to stay a pointer node")
+	}
+
+	if updated.Children[2] != view.Children[2] {
+		t.Fatal("expected the grid subtree off the path to be shared, not copied")
+	}
+	if !reflect.DeepEqual(updated.Children[0], view.Children[0]) {
+		t.Fatal("expected the title sibling to be unchanged")
+	}
+	dock, _ := updated.Find("dock")
+	if !reflect.DeepEqual(dock, TextNode{ID: "dock", Value: "Dock"}) {
+		t.Fatalf("expected the dock sibling to be unchanged, got %#v", dock)
+	}
+}
+
+func TestViewReplaceReportsMisses(t *testing.T) {
+	for _, id := range []string{"missing", ""} {
+		view := treeFixture()
+		updated, ok := view.Replace(id, TextNode{Value: "replacement"})
+		if ok {
+			t.Fatalf("%q: expected no replacement", id)
+		}
+		if !reflect.DeepEqual(updated, treeFixture()) {
+			t.Fatalf("%q: expected the view back unchanged", id)
+		}
+	}
+}
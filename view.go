@@ -1,16 +1,22 @@
 package bubbleviews
 
-import "strings"
+import (
+	"math"
+	"strings"
+)
 
 // View describes a rectangular region containing zero or more children.
 type View struct {
 	Size     Size
+	Overflow Overflow // applies when the view is rendered directly; boxes use BoxStyle.Overflow
 	Children []Node
 }
 
-// Node represents a renderable element in the view tree.
+// Node represents a renderable element in the view tree. Every node carries an
+// optional ID so update code can address it; see View.Find.
 type Node interface {
 	isNode()
+	nodeID() string
 }
 
 // Size represents a width and height measured in terminal cells.
@@ -21,34 +27,163 @@ type Size struct {
 
 // BoxNode draws a bordered container that can host another view.
 type BoxNode struct {
+	ID      string
 	Style   BoxStyle
 	Content View
 }
 
 func (BoxNode) isNode() {}
 
+func (n BoxNode) nodeID() string { return n.ID }
+
 // BoxStyle captures border, padding, fill, and alignment rules for a box.
 type BoxStyle struct {
-	Border      BorderStyle
-	BorderColor Color
-	Padding     Padding
-	FillWidth   bool
-	FillHeight  bool
-	HAlign      Alignment
-	VAlign      Alignment
+	Border       BorderStyle
+	CustomBorder BorderRunes // runes drawn when Border is BorderCustom
+	BorderSides  BorderSides // edges to draw; the zero value draws all four
+	BorderColor  Color
+	BorderColors BorderColors // per-edge colors; empty entries use BorderColor
+	Background   Color        // fills the whole box, border and padding included
+	Padding      Padding
+	Margin       Padding // space kept clear outside the border; FillWidth, FillHeight and relative sizes resolve within what remains
+	FillWidth    bool
+	FillHeight   bool
+	Width        Dimension // outer width relative to the parent; overrides FillWidth when set
+	Height       Dimension // outer height relative to the parent; overrides FillHeight when set
+	HAlign       Alignment
+	VAlign       Alignment
+	Overflow     Overflow // what happens when content is taller than a box with a resolved height
+	Title        string   // drawn into the top border line; needs a border
+	TitleAlign   Alignment
+	TitleStyle   TextStyle // defaults to the top border's color
+	Footer       string    // drawn into the bottom border line; needs a border
+	FooterAlign  Alignment
+	FooterStyle  TextStyle // defaults to the bottom border's color
+}
+
+// MarginNode insets any node from its surroundings. Containers size and place
+// the margin box, while the wrapped node lays out within the space left inside
+// it, so a FillWidth box wrapped in a margin still fills what remains.
+type MarginNode struct {
+	ID     string
+	Margin Padding
+	Node   Node
+}
+
+func (MarginNode) isNode() {}
+
+func (n MarginNode) nodeID() string { return n.ID }
+
+// SplitNode divides its space between two panes separated by a one-cell
+// divider line. FlexDirectionRow places the panes side by side and
+// FlexDirectionColumn stacks them. The first pane takes FirstSize cells when
+// set and otherwise Ratio of the space left beside the divider; both are then
+// held within the Min bounds. See input.SplitResizer for live resizing.
+type SplitNode struct {
+	ID           string
+	Direction    FlexDirection
+	First        Node
+	Second       Node
+	Ratio        float64 // share of the space given to the first pane, from 0 to 1; zero splits evenly
+	FirstSize    int     // fixed size of the first pane in cells; overrides Ratio
+	MinFirst     int
+	MinSecond    int
+	DividerColor Color
+}
+
+func (SplitNode) isNode() {}
+
+func (n SplitNode) nodeID() string { return n.ID }
+
+// PaneSizes splits total cells along the split's axis into the lengths of
+// the two panes, leaving one cell for the divider.
+func (s SplitNode) PaneSizes(total int) (first, second int) {
+	available := max(total-1, 0)
+	if s.FirstSize > 0 {
+		first = s.FirstSize
+	} else {
+		ratio := s.Ratio
+		if ratio <= 0 {
+			ratio = 0.5
+		}
+		first = int(math.Round(ratio * float64(available)))
+	}
+
+	first = min(first, available-max(s.MinSecond, 0))
+	first = max(first, s.MinFirst)
+	first = min(max(first, 0), available)
+	return first, available - first
+}
+
+// ScrollNode windows a child into the space its parent offers. The child is
+// laid out at the viewport's width and its full intrinsic height, then shifted
+// by the offsets so only the visible part is painted. Offsets beyond the end
+// of the content are clamped when rendering; read the resolved extent back
+// from render.Layout to clamp them in the model as well.
+type ScrollNode struct {
+	ID             string
+	Node           Node
+	OffsetX        int
+	OffsetY        int
+	Scrollbar      bool  // reserves the rightmost column for a vertical scrollbar
+	ScrollbarColor Color // track color
+	ThumbColor     Color
+}
+
+func (ScrollNode) isNode() {}
+
+func (n ScrollNode) nodeID() string { return n.ID }
+
+// VirtualListNode shows a window of Count rows, building only the rows that
+// are visible. Row is called with each visible index in turn and the result
+// is laid out in a slot RowHeight cells tall; taller rows are clipped to their
+// slot. Offset is the index of the first visible row and is clamped when
+// rendering. The list fills the height its parent offers, so place it where
+// that height is resolved (a FillHeight box, a growing flex item); without one
+// every row is built.
+type VirtualListNode struct {
+	ID             string
+	Count          int
+	RowHeight      int // cells per row; defaults to 1
+	Offset         int
+	Row            func(index int) Node
+	Scrollbar      bool // reserves the rightmost column for a vertical scrollbar
+	ScrollbarColor Color
+	ThumbColor     Color
+}
+
+func (VirtualListNode) isNode() {}
+
+func (n VirtualListNode) nodeID() string { return n.ID }
+
+// ClampScroll bounds offset so a viewport of the given length stays within
+// content of the given length.
+func ClampScroll(offset, content, viewport int) int {
+	return max(min(offset, content-viewport), 0)
 }
 
 // FlexNode arranges child nodes along a single axis.
 type FlexNode struct {
-	Direction FlexDirection
-	Spacing   int
-	Items     []FlexItem
+	ID         string
+	Direction  FlexDirection
+	Spacing    int
+	AlignItems FlexAlign   // cross-axis placement for items without AlignSelf
+	Justify    FlexJustify // main-axis placement of any leftover space
+	Items      []FlexItem
+
+	// CollapseBorders overlaps neighbouring items by one cell, ignoring
+	// Spacing, so bordered boxes share a single line that meets the frames
+	// around it in junctions such as ┬ and ┤.
+	CollapseBorders bool
 }
 
 func (FlexNode) isNode() {}
 
+func (n FlexNode) nodeID() string { return n.ID }
+
 // FlowNode arranges child nodes in rows, wrapping when exceeding available width.
 type FlowNode struct {
+	ID           string
 	ItemMinWidth int
 	ItemSpacing  int
 	RowSpacing   int
@@ -57,22 +192,92 @@ type FlowNode struct {
 
 func (FlowNode) isNode() {}
 
+func (n FlowNode) nodeID() string { return n.ID }
+
+// GridNode places child nodes on explicit column and row tracks. Cells that
+// land beyond the declared tracks extend the grid with auto-sized tracks.
+type GridNode struct {
+	ID        string
+	Columns   []Dimension
+	Rows      []Dimension
+	ColumnGap int
+	RowGap    int
+	Cells     []GridCell
+
+	// CollapseBorders overlaps neighbouring tracks by one cell, ignoring the
+	// gaps, so the borders of boxes in adjacent cells share one line with
+	// junctions such as ┼ where they cross. Cells should fill their slots.
+	CollapseBorders bool
+}
+
+func (GridNode) isNode() {}
+
+func (n GridNode) nodeID() string { return n.ID }
+
+// GridCell positions a node on a GridNode using zero-based track indexes.
+type GridCell struct {
+	Node    Node
+	Row     int
+	Column  int
+	RowSpan int // number of row tracks covered; defaults to 1
+	ColSpan int // number of column tracks covered; defaults to 1
+}
+
+// LayerNode stacks layers on top of one another. Layers paint in order, each
+// composited cell by cell over the ones before it, so the first layer acts as
+// the base and later layers float above it as modals or popovers.
+type LayerNode struct {
+	ID     string
+	Layers []Layer
+}
+
+func (LayerNode) isNode() {}
+
+func (n LayerNode) nodeID() string { return n.ID }
+
+// Layer positions a node inside a LayerNode. HAlign and VAlign anchor the
+// layer against the stack's edges or center, and X/Y offset it from there.
+// Every layer is offered the stack's full size, so nodes that fill their
+// parent (text, FillWidth boxes) span the stack; wrap compact popovers in a
+// plain BoxNode.
+type Layer struct {
+	Node   Node
+	HAlign Alignment
+	VAlign Alignment
+	X      int
+	Y      int
+}
+
 // ASCIIArtNode renders preformatted ASCII art lines.
 type ASCIIArtNode struct {
+	ID    string
 	Lines []string
 	Align Alignment
-	Bold  bool
+	Style TextStyle // a Background fills the node's whole width, alignment space included
+
+	// Deprecated: Use Style.Color; a color set there wins.
 	Color Color
+	// Deprecated: Use Style.Bold.
+	Bold bool
 }
 
 func (ASCIIArtNode) isNode() {}
 
+func (n ASCIIArtNode) nodeID() string { return n.ID }
+
 // FlexItem references a node within a Flex layout.
 type FlexItem struct {
-	Node   Node
-	Width  int // used when Direction == FlexDirectionRow
-	Height int // used when Direction == FlexDirectionColumn
-	Grow   int // relative weight when sharing remaining width in rows
+	Node      Node
+	Basis     Dimension // main-axis size; takes precedence over Width/Height when set
+	Width     int       // used when Direction == FlexDirectionRow
+	Height    int       // used when Direction == FlexDirectionColumn
+	Grow      int       // relative weight when sharing remaining space along the main axis
+	Shrink    int       // relative weight when giving up space on overflow; all items shrink evenly when none set it
+	MinWidth  int       // lower bound in cells on the main-axis size when Direction == FlexDirectionRow
+	MaxWidth  int       // upper bound in cells on the main-axis size in a row; zero means unbounded
+	MinHeight int       // lower bound in cells on the main-axis size when Direction == FlexDirectionColumn
+	MaxHeight int       // upper bound in cells on the main-axis size in a column; zero means unbounded
+	AlignSelf FlexAlign // overrides FlexNode.AlignItems for this item
 }
 
 // FlexDirection expresses whether a FlexNode lays out children in a row or column.
@@ -83,22 +288,146 @@ const (
 	FlexDirectionColumn
 )
 
+// FlexAlign describes where items sit along a flex container's cross axis.
+type FlexAlign string
+
+const (
+	FlexAlignStart   FlexAlign = "start"
+	FlexAlignCenter  FlexAlign = "center"
+	FlexAlignEnd     FlexAlign = "end"
+	FlexAlignStretch FlexAlign = "stretch"
+)
+
+// FlexJustify describes how leftover main-axis space is spread around items.
+type FlexJustify string
+
+const (
+	FlexJustifyStart        FlexJustify = "start"
+	FlexJustifyCenter       FlexJustify = "center"
+	FlexJustifyEnd          FlexJustify = "end"
+	FlexJustifySpaceBetween FlexJustify = "space-between"
+	FlexJustifySpaceAround  FlexJustify = "space-around"
+	FlexJustifySpaceEvenly  FlexJustify = "space-evenly"
+)
+
 // TextNode renders raw text with optional formatting.
 type TextNode struct {
+	ID                 string
 	Value              string
-	Color              Color
-	Bold               bool
+	Style              TextStyle // a Background fills the node's whole width, alignment space included
 	Wrap               bool
+	WrapMode           WrapMode // where Wrap breaks lines
+	Hyphenate          bool     // marks words Wrap breaks apart with a hyphen; soft hyphens (U+00AD) always break that way
 	Truncate           bool
 	TruncateSuffix     string
 	Align              Alignment
 	Prefix             string
 	ContinuationPrefix string
+
+	// Deprecated: Use Style.Color; a color set there wins.
+	Color Color
+	// Deprecated: Use Style.Bold.
+	Bold bool
 }
 
 func (TextNode) isNode() {}
 
-// Padding expresses the inset around content.
+func (n TextNode) nodeID() string { return n.ID }
+
+// RichTextNode renders a paragraph made of differently styled spans. Wrapping,
+// truncation and alignment treat the spans as one run of text, so a word may
+// continue from one span into the next and a line break can fall inside a
+// span.
+type RichTextNode struct {
+	ID             string
+	Spans          []Span
+	Style          TextStyle // base style every span builds on; a Background fills the node's whole width
+	Wrap           bool
+	WrapMode       WrapMode // where Wrap breaks lines
+	Hyphenate      bool     // marks words Wrap breaks apart with a hyphen; soft hyphens (U+00AD) always break that way
+	Truncate       bool
+	TruncateSuffix string
+	Align          Alignment
+}
+
+func (RichTextNode) isNode() {}
+
+func (n RichTextNode) nodeID() string { return n.ID }
+
+// Span is a piece of a RichTextNode painted in its own style. Colors left
+// empty and attributes left off fall back to the node's Style.
+type Span struct {
+	Text  string
+	Style TextStyle
+}
+
+// TextStyle holds the color and attributes of drawn text. It is shared by
+// text, rich text spans, ASCII art and box titles. Terminals that lack an
+// attribute, commonly blink or italic, ignore it.
+type TextStyle struct {
+	Color         Color
+	Background    Color
+	Bold          bool
+	Italic        bool
+	Underline     bool
+	Strikethrough bool
+	Faint         bool // dimmed, for disabled or secondary content
+	Reverse       bool // swaps foreground and background
+	Blink         bool
+}
+
+// Inherit layers s over base: colors s leaves empty and attributes it leaves
+// off come from base.
+func (s TextStyle) Inherit(base TextStyle) TextStyle {
+	if s.Color == "" {
+		s.Color = base.Color
+	}
+	if s.Background == "" {
+		s.Background = base.Background
+	}
+	s.Bold = s.Bold || base.Bold
+	s.Italic = s.Italic || base.Italic
+	s.Underline = s.Underline || base.Underline
+	s.Strikethrough = s.Strikethrough || base.Strikethrough
+	s.Faint = s.Faint || base.Faint
+	s.Reverse = s.Reverse || base.Reverse
+	s.Blink = s.Blink || base.Blink
+	return s
+}
+
+// Dimension expresses a length along one axis. The zero value is auto, which
+// leaves sizing to the node's content or the container's defaults.
+type Dimension struct {
+	Unit  SizeUnit
+	Value int
+}
+
+// SizeUnit enumerates how a Dimension's value is interpreted.
+type SizeUnit int
+
+const (
+	UnitAuto     SizeUnit = iota
+	UnitCells             // Value is an absolute number of terminal cells
+	UnitPercent           // Value is a percentage of the space the parent offers
+	UnitFraction          // Value is a share of whatever space remains after other items
+)
+
+// Cells returns a Dimension of n terminal cells.
+func Cells(n int) Dimension {
+	return Dimension{Unit: UnitCells, Value: n}
+}
+
+// Percent returns a Dimension covering p percent of the parent's space.
+func Percent(p int) Dimension {
+	return Dimension{Unit: UnitPercent, Value: p}
+}
+
+// Fraction returns a Dimension claiming n shares of the remaining space.
+func Fraction(n int) Dimension {
+	return Dimension{Unit: UnitFraction, Value: n}
+}
+
+// Padding expresses the inset around content. It also describes margins.
 type Padding struct {
 	Top, Right, Bottom, Left int
 }
@@ -107,11 +436,46 @@ type Padding struct {
 type BorderStyle string
 
 const (
-	BorderNone  BorderStyle = "none"
-	BorderThin  BorderStyle = "normal"
-	BorderThick BorderStyle = "thick"
+	BorderNone           BorderStyle = "none"
+	BorderThin           BorderStyle = "normal"
+	BorderThick          BorderStyle = "thick"
+	BorderRounded        BorderStyle = "rounded"
+	BorderDouble         BorderStyle = "double"
+	BorderHidden         BorderStyle = "hidden" // blank frame that keeps the border's space
+	BorderBlock          BorderStyle = "block"
+	BorderOuterHalfBlock BorderStyle = "outer-half-block"
+	BorderASCII          BorderStyle = "ascii" // +, - and | only, for terminals without box-drawing glyphs
+	BorderCustom         BorderStyle = "custom"
 )
 
+// BorderRunes defines a border glyph by glyph for BorderCustom. Each entry
+// should occupy a single cell; empty entries leave their cells blank.
+type BorderRunes struct {
+	Top         string
+	Bottom      string
+	Left        string
+	Right       string
+	TopLeft     string
+	TopRight    string
+	BottomLeft  string
+	BottomRight string
+}
+
+// BorderSides picks which edges of a border are drawn, such as only Left for
+// an accent bar or only Bottom for a rule under a header. Leaving every field
+// false draws the full frame. Only drawn edges take up space, and corners
+// appear where two drawn edges meet; otherwise an edge runs to the end of
+// the box.
+type BorderSides struct {
+	Top, Right, Bottom, Left bool
+}
+
+// BorderColors colors each edge of a border separately. Corners take the
+// color of the top or bottom edge they close.
+type BorderColors struct {
+	Top, Right, Bottom, Left Color
+}
+
 // Alignment describes horizontal or vertical placement.
 type Alignment string
 
@@ -121,6 +485,36 @@ const (
 	AlignEnd    Alignment = "end"
 )
 
+// Overflow describes how a region treats content that does not fit inside
+// its size. The zero value behaves like OverflowVisible.
+type Overflow string
+
+const (
+	// OverflowVisible lets the region grow to fit its content.
+	OverflowVisible Overflow = "visible"
+	// OverflowClip holds the region at its size and cuts off whatever spills
+	// past it.
+	OverflowClip Overflow = "clip"
+	// OverflowEllipsisRow clips like OverflowClip and replaces the last
+	// visible row with an ellipsis when rows were cut off.
+	OverflowEllipsisRow Overflow = "ellipsis-row"
+)
+
+// WrapMode selects where wrapped text breaks into lines. Newlines in the text
+// always start a new line. The zero value behaves like WrapWord.
+type WrapMode string
+
+const (
+	// WrapWord breaks between words and collapses runs of whitespace. Words
+	// wider than the line are broken wherever they have to be.
+	WrapWord WrapMode = "word"
+	// WrapCharacter fills every line to the full width, breaking mid-word.
+	WrapCharacter WrapMode = "character"
+	// WrapPreserve breaks between words like WrapWord but keeps spaces and
+	// indentation as written.
+	WrapPreserve WrapMode = "preserve"
+)
+
 // Color is a free-form string keyed by the renderer.
 type Color string
 
@@ -142,8 +536,7 @@ func (l ListView) Node() Node {
 		items = append(items, FlexItem{
 			Node: TextNode{
 				Value: l.Title,
-				Color: l.TitleColor,
-				Bold:  true,
+				Style: TextStyle{Color: l.TitleColor, Bold: true},
 			},
 		})
 	}
@@ -158,7 +551,7 @@ func (l ListView) Node() Node {
 		items = append(items, FlexItem{
 			Node: TextNode{
 				Value:              item,
-				Color:              l.ItemColor,
+				Style:              TextStyle{Color: l.ItemColor},
 				Wrap:               true,
 				Prefix:             bullet,
 				ContinuationPrefix: continuation,
@@ -199,3 +592,83 @@ func (r EqualWidthRow) Node() Node {
 		Items:     items,
 	}
 }
+
+// EvenRowGrid chunks items into rows whose columns share identical widths,
+// including a short final row.
+type EvenRowGrid struct {
+	Items      []EvenRowItem
+	MaxPerRow  int
+	Spacing    int
+	RowSpacing int
+	RowHeight  int // height of every row; zero splits the height the parent offers evenly
+}
+
+// EvenRowItem places a node into an EvenRowGrid slot.
+type EvenRowItem struct {
+	Node         Node
+	WidthPercent int // share of the row width, read from first-row items only; zero splits what remains evenly
+}
+
+// Node returns a column flex of rows. Columns take their widths from the first
+// row, so WidthPercent on later items is ignored, and short rows are padded
+// with empty slots so they line up with the rows above. Every card in a row is
+// stretched to the row's height. Without RowHeight the rows grow to share the
+// parent's height equally, so place the grid where that height is resolved (a
+// FillHeight box, a growing flex item); a row whose cards need more keeps
+// their height, as does every row when no height is offered.
+func (g EvenRowGrid) Node() Node {
+	if len(g.Items) == 0 {
+		return FlexNode{Direction: FlexDirectionColumn}
+	}
+
+	perRow := g.MaxPerRow
+	if perRow <= 0 || perRow > len(g.Items) {
+		perRow = len(g.Items)
+	}
+
+	columns := make([]Dimension, perRow)
+	for i := range columns {
+		columns[i] = evenRowBasis(g.Items[i])
+	}
+
+	rows := make([]FlexItem, 0, (len(g.Items)+perRow-1)/perRow)
+	for start := 0; start < len(g.Items); start += perRow {
+		cells := make([]FlexItem, perRow)
+		for col := range cells {
+			cells[col] = FlexItem{
+				Node:  TextNode{},
+				Basis: columns[col],
+			}
+			if idx := start + col; idx < len(g.Items) {
+				cells[col].Node = g.Items[idx].Node
+			}
+		}
+
+		row := FlexItem{
+			Node: FlexNode{
+				Direction:  FlexDirectionRow,
+				Spacing:    g.Spacing,
+				AlignItems: FlexAlignStretch,
+				Items:      cells,
+			},
+			Height: g.RowHeight,
+		}
+		if g.RowHeight <= 0 {
+			row.Grow = 1
+		}
+		rows = append(rows, row)
+	}
+
+	return FlexNode{
+		Direction: FlexDirectionColumn,
+		Spacing:   g.RowSpacing,
+		Items:     rows,
+	}
+}
+
+func evenRowBasis(item EvenRowItem) Dimension {
+	if item.WidthPercent > 0 {
+		return Percent(item.WidthPercent)
+	}
+	return Fraction(1)
+}
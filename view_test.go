@@ -0,0 +1,37 @@
+package bubbleviews
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSplitNodePaneSizes(t *testing.T) {
+	// A total of 21 cells leaves 20 for the panes beside the divider.
+	tests := []struct {
+		name  string
+		split SplitNode
+		total int
+		want  []int
+	}{
+		{name: "unset ratio splits evenly", total: 21, want: []int{10, 10}},
+		{name: "ratio", split: SplitNode{Ratio: 0.25}, total: 21, want: []int{5, 15}},
+		{name: "tiny ratio empties the first pane", split: SplitNode{Ratio: 1e-6}, total: 21, want: []int{0, 20}},
+		{name: "full ratio empties the second pane", split: SplitNode{Ratio: 1}, total: 21, want: []int{20, 0}},
+		{name: "first size overrides ratio", split: SplitNode{Ratio: 0.9, FirstSize: 8}, total: 21, want: []int{8, 12}},
+		{name: "first size is capped by the space", split: SplitNode{FirstSize: 30}, total: 21, want: []int{20, 0}},
+		{name: "min first", split: SplitNode{Ratio: 0.1, MinFirst: 6}, total: 21, want: []int{6, 14}},
+		{name: "min second", split: SplitNode{Ratio: 0.9, MinSecond: 6}, total: 21, want: []int{14, 6}},
+		{name: "min first wins when both cannot fit", split: SplitNode{MinFirst: 15, MinSecond: 15}, total: 21, want: []int{15, 5}},
+		{name: "no room beside the divider", total: 1, want: []int{0, 0}},
+		{name: "no space", split: SplitNode{MinFirst: 4}, total: 0, want: []int{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second := tt.split.PaneSizes(tt.total)
+			if got := []int{first, second}; fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}